@@ -0,0 +1,484 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValueKind is the type of the value held by a Value.
+type ValueKind int
+
+const (
+	KindString ValueKind = iota
+	KindInt64
+	KindFloat64
+	KindBool
+	KindTime
+	KindDuration
+	KindMap
+	KindSlice
+)
+
+var valueKindNames = map[ValueKind]string{
+	KindString:   "string",
+	KindInt64:    "int64",
+	KindFloat64:  "float64",
+	KindBool:     "bool",
+	KindTime:     "time",
+	KindDuration: "duration",
+	KindMap:      "map",
+	KindSlice:    "slice",
+}
+
+// String returns the name of the kind.
+func (k ValueKind) String() string {
+	if name, ok := valueKindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("ValueKind(%d)", int(k))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (k ValueKind) MarshalText() ([]byte, error) {
+	if _, ok := valueKindNames[k]; !ok {
+		return nil, fmt.Errorf("yeterr: unknown value kind %d", int(k))
+	}
+
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (k *ValueKind) UnmarshalText(text []byte) error {
+	for kind, name := range valueKindNames {
+		if name == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+
+	return fmt.Errorf("yeterr: unknown value kind %q", string(text))
+}
+
+// Value is a typed metadata value. The zero value is an empty string.
+type Value struct {
+	kind  ValueKind
+	value interface{}
+}
+
+// StringValue returns a value holding a string.
+func StringValue(v string) Value {
+	return Value{kind: KindString, value: v}
+}
+
+// Int64Value returns a value holding an int64.
+func Int64Value(v int64) Value {
+	return Value{kind: KindInt64, value: v}
+}
+
+// IntValue returns a value holding an int as int64.
+func IntValue(v int) Value {
+	return Int64Value(int64(v))
+}
+
+// Float64Value returns a value holding a float64.
+func Float64Value(v float64) Value {
+	return Value{kind: KindFloat64, value: v}
+}
+
+// BoolValue returns a value holding a bool.
+func BoolValue(v bool) Value {
+	return Value{kind: KindBool, value: v}
+}
+
+// TimeValue returns a value holding a time.
+func TimeValue(v time.Time) Value {
+	return Value{kind: KindTime, value: v}
+}
+
+// DurationValue returns a value holding a duration.
+func DurationValue(v time.Duration) Value {
+	return Value{kind: KindDuration, value: v}
+}
+
+// MapValue returns a value holding nested attributes.
+func MapValue(v Attributes) Value {
+	return Value{kind: KindMap, value: v}
+}
+
+// SliceValue returns a value holding a slice of values.
+func SliceValue(v ...Value) Value {
+	return Value{kind: KindSlice, value: v}
+}
+
+// AnyValue returns a value for common Go types. Integers become int64, floats become float64, maps with string keys
+// become nested attributes and slices become slices of values. Every other type is stored as its string
+// representation.
+func AnyValue(v interface{}) Value {
+	switch typed := v.(type) {
+	case Value:
+		return typed
+	case string:
+		return StringValue(typed)
+	case int:
+		return Int64Value(int64(typed))
+	case int8:
+		return Int64Value(int64(typed))
+	case int16:
+		return Int64Value(int64(typed))
+	case int32:
+		return Int64Value(int64(typed))
+	case int64:
+		return Int64Value(typed)
+	case uint8:
+		return Int64Value(int64(typed))
+	case uint16:
+		return Int64Value(int64(typed))
+	case uint32:
+		return Int64Value(int64(typed))
+	case float32:
+		return Float64Value(float64(typed))
+	case float64:
+		return Float64Value(typed)
+	case bool:
+		return BoolValue(typed)
+	case time.Time:
+		return TimeValue(typed)
+	case time.Duration:
+		return DurationValue(typed)
+	case Attributes:
+		return MapValue(typed)
+	case ErrorMetadata:
+		return MapValue(typed.ToAttributes())
+	case map[string]interface{}:
+		attributes := make(Attributes, len(typed))
+		for key, value := range typed {
+			attributes[key] = AnyValue(value)
+		}
+
+		return MapValue(attributes)
+	case []Value:
+		return SliceValue(typed...)
+	case []interface{}:
+		values := make([]Value, 0, len(typed))
+		for _, value := range typed {
+			values = append(values, AnyValue(value))
+		}
+
+		return SliceValue(values...)
+	default:
+		return StringValue(fmt.Sprint(typed))
+	}
+}
+
+// Kind returns the kind of the value.
+func (v Value) Kind() ValueKind {
+	return v.kind
+}
+
+// Any returns the value as interface.
+func (v Value) Any() interface{} {
+	if v.value == nil && v.kind == KindString {
+		return ""
+	}
+
+	return v.value
+}
+
+// AsString returns the string and true if the value holds a string.
+func (v Value) AsString() (string, bool) {
+	if v.kind != KindString {
+		return "", false
+	}
+
+	s, _ := v.value.(string)
+	return s, true
+}
+
+// AsInt64 returns the int64 and true if the value holds an int64.
+func (v Value) AsInt64() (int64, bool) {
+	i, ok := v.value.(int64)
+	return i, ok && v.kind == KindInt64
+}
+
+// AsFloat64 returns the float64 and true if the value holds a float64.
+func (v Value) AsFloat64() (float64, bool) {
+	f, ok := v.value.(float64)
+	return f, ok && v.kind == KindFloat64
+}
+
+// AsBool returns the bool and true if the value holds a bool.
+func (v Value) AsBool() (bool, bool) {
+	b, ok := v.value.(bool)
+	return b, ok && v.kind == KindBool
+}
+
+// AsTime returns the time and true if the value holds a time.
+func (v Value) AsTime() (time.Time, bool) {
+	t, ok := v.value.(time.Time)
+	return t, ok && v.kind == KindTime
+}
+
+// AsDuration returns the duration and true if the value holds a duration.
+func (v Value) AsDuration() (time.Duration, bool) {
+	d, ok := v.value.(time.Duration)
+	return d, ok && v.kind == KindDuration
+}
+
+// AsMap returns the nested attributes and true if the value holds nested attributes.
+func (v Value) AsMap() (Attributes, bool) {
+	m, ok := v.value.(Attributes)
+	return m, ok && v.kind == KindMap
+}
+
+// AsSlice returns the slice of values and true if the value holds a slice.
+func (v Value) AsSlice() ([]Value, bool) {
+	s, ok := v.value.([]Value)
+	return s, ok && v.kind == KindSlice
+}
+
+// String returns a string representation of the value. Times use RFC 3339, maps and slices use a bracket notation.
+func (v Value) String() string {
+	switch v.kind {
+	case KindString:
+		s, _ := v.AsString()
+		return s
+	case KindInt64:
+		i, _ := v.AsInt64()
+		return strconv.FormatInt(i, 10)
+	case KindFloat64:
+		f, _ := v.AsFloat64()
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	case KindBool:
+		b, _ := v.AsBool()
+		return strconv.FormatBool(b)
+	case KindTime:
+		t, _ := v.AsTime()
+		return t.Format(time.RFC3339Nano)
+	case KindDuration:
+		d, _ := v.AsDuration()
+		return d.String()
+	case KindMap:
+		m, _ := v.AsMap()
+		return m.String()
+	case KindSlice:
+		s, _ := v.AsSlice()
+		parts := make([]string, 0, len(s))
+		for _, value := range s {
+			parts = append(parts, value.String())
+		}
+
+		return "[" + strings.Join(parts, " ") + "]"
+	default:
+		return fmt.Sprint(v.value)
+	}
+}
+
+// valueJSON is the JSON representation of a value. The kind is stored next to the value, so decoding is lossless.
+type valueJSON struct {
+	Kind  ValueKind       `json:"kind"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Value) MarshalJSON() ([]byte, error) {
+	var payload interface{}
+	switch v.kind {
+	case KindTime:
+		t, _ := v.AsTime()
+		payload = t.Format(time.RFC3339Nano)
+	case KindDuration:
+		d, _ := v.AsDuration()
+		payload = int64(d)
+	default:
+		payload = v.Any()
+	}
+
+	rawValue, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(valueJSON{
+		Kind:  v.kind,
+		Value: rawValue,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var decoded valueJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var err error
+	switch decoded.Kind {
+	case KindString:
+		var s string
+		err = json.Unmarshal(decoded.Value, &s)
+		*v = StringValue(s)
+	case KindInt64:
+		var i int64
+		err = json.Unmarshal(decoded.Value, &i)
+		*v = Int64Value(i)
+	case KindFloat64:
+		var f float64
+		err = json.Unmarshal(decoded.Value, &f)
+		*v = Float64Value(f)
+	case KindBool:
+		var b bool
+		err = json.Unmarshal(decoded.Value, &b)
+		*v = BoolValue(b)
+	case KindTime:
+		var t time.Time
+		err = json.Unmarshal(decoded.Value, &t)
+		*v = TimeValue(t)
+	case KindDuration:
+		var d int64
+		err = json.Unmarshal(decoded.Value, &d)
+		*v = DurationValue(time.Duration(d))
+	case KindMap:
+		var m Attributes
+		err = json.Unmarshal(decoded.Value, &m)
+		*v = MapValue(m)
+	case KindSlice:
+		var s []Value
+		err = json.Unmarshal(decoded.Value, &s)
+		*v = SliceValue(s...)
+	}
+
+	return err
+}
+
+// Attributes is a metadata container holding typed values.
+type Attributes map[string]Value
+
+// GetString returns the string value for the key and true if the key exists and holds a string.
+func (a Attributes) GetString(key string) (string, bool) {
+	value, ok := a[key]
+	if !ok {
+		return "", false
+	}
+
+	return value.AsString()
+}
+
+// GetInt64 returns the int64 value for the key and true if the key exists and holds an int64.
+func (a Attributes) GetInt64(key string) (int64, bool) {
+	value, ok := a[key]
+	if !ok {
+		return 0, false
+	}
+
+	return value.AsInt64()
+}
+
+// GetFloat64 returns the float64 value for the key and true if the key exists and holds a float64.
+func (a Attributes) GetFloat64(key string) (float64, bool) {
+	value, ok := a[key]
+	if !ok {
+		return 0, false
+	}
+
+	return value.AsFloat64()
+}
+
+// GetBool returns the bool value for the key and true if the key exists and holds a bool.
+func (a Attributes) GetBool(key string) (bool, bool) {
+	value, ok := a[key]
+	if !ok {
+		return false, false
+	}
+
+	return value.AsBool()
+}
+
+// GetTime returns the time value for the key and true if the key exists and holds a time.
+func (a Attributes) GetTime(key string) (time.Time, bool) {
+	value, ok := a[key]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return value.AsTime()
+}
+
+// GetDuration returns the duration value for the key and true if the key exists and holds a duration.
+func (a Attributes) GetDuration(key string) (time.Duration, bool) {
+	value, ok := a[key]
+	if !ok {
+		return 0, false
+	}
+
+	return value.AsDuration()
+}
+
+// GetMap returns the nested attributes for the key and true if the key exists and holds nested attributes.
+func (a Attributes) GetMap(key string) (Attributes, bool) {
+	value, ok := a[key]
+	if !ok {
+		return nil, false
+	}
+
+	return value.AsMap()
+}
+
+// GetSlice returns the slice of values for the key and true if the key exists and holds a slice.
+func (a Attributes) GetSlice(key string) ([]Value, bool) {
+	value, ok := a[key]
+	if !ok {
+		return nil, false
+	}
+
+	return value.AsSlice()
+}
+
+// ToErrorMetadata converts the attributes into error metadata. Every value is stored as its string representation.
+func (a Attributes) ToErrorMetadata() ErrorMetadata {
+	if a == nil {
+		return nil
+	}
+
+	metadata := make(ErrorMetadata, len(a))
+	for key, value := range a {
+		metadata[key] = value.String()
+	}
+
+	return metadata
+}
+
+// String returns the attributes sorted by key in the format "[key:value key:value]".
+func (a Attributes) String() string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+":"+a[key].String())
+	}
+
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// ToAttributes converts the error metadata into attributes holding string values.
+func (m ErrorMetadata) ToAttributes() Attributes {
+	if m == nil {
+		return nil
+	}
+
+	attributes := make(Attributes, len(m))
+	for key, value := range m {
+		attributes[key] = StringValue(value)
+	}
+
+	return attributes
+}
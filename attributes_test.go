@@ -0,0 +1,189 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAttributes = Attributes{
+	"filename": StringValue("text.txt"),
+	"retries":  Int64Value(3),
+	"ratio":    Float64Value(0.25),
+	"partial":  BoolValue(true),
+	"started":  TimeValue(referenceTime),
+	"timeout":  DurationValue(1500 * time.Millisecond),
+	"user": MapValue(Attributes{
+		"id": Int64Value(9007199254740993),
+	}),
+	"lines": SliceValue(IntValue(3), IntValue(12)),
+}
+
+func TestValue_Kind_and_Getters(t *testing.T) {
+	t.Run("should use an empty string as zero value", func(t *testing.T) {
+		var value Value
+		s, ok := value.AsString()
+
+		assert.Equal(t, KindString, value.Kind())
+		assert.True(t, ok)
+		assert.Equal(t, "", s)
+		assert.Equal(t, "", value.Any())
+	})
+
+	t.Run("should return typed values only for the matching kind", func(t *testing.T) {
+		value := Int64Value(42)
+
+		i, ok := value.AsInt64()
+		assert.True(t, ok)
+		assert.Equal(t, int64(42), i)
+
+		_, ok = value.AsString()
+		assert.False(t, ok)
+		_, ok = value.AsFloat64()
+		assert.False(t, ok)
+	})
+}
+
+func TestValue_String(t *testing.T) {
+	assert.Equal(t, "text.txt", testAttributes["filename"].String())
+	assert.Equal(t, "3", testAttributes["retries"].String())
+	assert.Equal(t, "0.25", testAttributes["ratio"].String())
+	assert.Equal(t, "true", testAttributes["partial"].String())
+	assert.Equal(t, "2020-04-01T12:00:00Z", testAttributes["started"].String())
+	assert.Equal(t, "1.5s", testAttributes["timeout"].String())
+	assert.Equal(t, "[id:9007199254740993]", testAttributes["user"].String())
+	assert.Equal(t, "[3 12]", testAttributes["lines"].String())
+}
+
+func TestAnyValue(t *testing.T) {
+	assert.Equal(t, StringValue("a"), AnyValue("a"))
+	assert.Equal(t, Int64Value(1), AnyValue(1))
+	assert.Equal(t, Int64Value(2), AnyValue(uint16(2)))
+	assert.Equal(t, Float64Value(0.5), AnyValue(float32(0.5)))
+	assert.Equal(t, BoolValue(true), AnyValue(true))
+	assert.Equal(t, DurationValue(time.Second), AnyValue(time.Second))
+	assert.Equal(t, TimeValue(referenceTime), AnyValue(referenceTime))
+	assert.Equal(t, MapValue(Attributes{"a": StringValue("b")}), AnyValue(ErrorMetadata{"a": "b"}))
+	assert.Equal(t, MapValue(Attributes{"a": Int64Value(1)}), AnyValue(map[string]interface{}{"a": 1}))
+	assert.Equal(t, SliceValue(StringValue("a"), Int64Value(1)), AnyValue([]interface{}{"a", 1}))
+	assert.Equal(t, StringValue("[1 2]"), AnyValue([]uint64{1, 2}))
+}
+
+func TestAttributes_Getters(t *testing.T) {
+	t.Run("should return typed values", func(t *testing.T) {
+		filename, ok := testAttributes.GetString("filename")
+		assert.True(t, ok)
+		assert.Equal(t, "text.txt", filename)
+
+		retries, ok := testAttributes.GetInt64("retries")
+		assert.True(t, ok)
+		assert.Equal(t, int64(3), retries)
+
+		ratio, ok := testAttributes.GetFloat64("ratio")
+		assert.True(t, ok)
+		assert.Equal(t, 0.25, ratio)
+
+		partial, ok := testAttributes.GetBool("partial")
+		assert.True(t, ok)
+		assert.True(t, partial)
+
+		started, ok := testAttributes.GetTime("started")
+		assert.True(t, ok)
+		assert.Equal(t, referenceTime, started)
+
+		timeout, ok := testAttributes.GetDuration("timeout")
+		assert.True(t, ok)
+		assert.Equal(t, 1500*time.Millisecond, timeout)
+
+		user, ok := testAttributes.GetMap("user")
+		require.True(t, ok)
+		userID, ok := user.GetInt64("id")
+		assert.True(t, ok)
+		assert.Equal(t, int64(9007199254740993), userID)
+
+		lines, ok := testAttributes.GetSlice("lines")
+		assert.True(t, ok)
+		assert.Equal(t, []Value{IntValue(3), IntValue(12)}, lines)
+	})
+
+	t.Run("should return false for missing keys and other kinds", func(t *testing.T) {
+		_, ok := testAttributes.GetString("missing")
+		assert.False(t, ok)
+
+		_, ok = testAttributes.GetString("retries")
+		assert.False(t, ok)
+	})
+}
+
+func TestAttributes_JSON(t *testing.T) {
+	t.Run("should round-trip all kinds without losing type information", func(t *testing.T) {
+		data, err := json.Marshal(testAttributes)
+		require.NoError(t, err)
+
+		var decoded Attributes
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Equal(t, testAttributes, decoded)
+	})
+
+	t.Run("should marshal kind and value", func(t *testing.T) {
+		data, err := json.Marshal(Attributes{"timeout": DurationValue(time.Second)})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"timeout": {"kind": "duration", "value": 1000000000}}`, string(data))
+	})
+
+	t.Run("should return an error for an unknown kind", func(t *testing.T) {
+		var decoded Attributes
+		assert.Error(t, json.Unmarshal([]byte(`{"a": {"kind": "complex", "value": 1}}`), &decoded))
+	})
+}
+
+func TestAttributes_ErrorMetadata_Conversion(t *testing.T) {
+	t.Run("should convert attributes to error metadata", func(t *testing.T) {
+		metadata := Attributes{
+			"retries": Int64Value(3),
+			"timeout": DurationValue(time.Second),
+		}.ToErrorMetadata()
+
+		assert.Equal(t, ErrorMetadata{"retries": "3", "timeout": "1s"}, metadata)
+	})
+
+	t.Run("should convert error metadata to attributes", func(t *testing.T) {
+		attributes := ErrorMetadata{"filename": "text.txt"}.ToAttributes()
+		assert.Equal(t, Attributes{"filename": StringValue("text.txt")}, attributes)
+	})
+
+	t.Run("should keep nil values", func(t *testing.T) {
+		assert.Nil(t, Attributes(nil).ToErrorMetadata())
+		assert.Nil(t, ErrorMetadata(nil).ToAttributes())
+	})
+}
+
+func TestReportError_Attribute(t *testing.T) {
+	reportErr := ReportError{
+		WrappedError: errReadError,
+		Metadata:     ErrorMetadata{"filename": "text.txt", "retries": "1"},
+		Attributes:   Attributes{"retries": Int64Value(3)},
+	}
+
+	t.Run("should prefer attributes over metadata", func(t *testing.T) {
+		value, ok := reportErr.Attribute("retries")
+		assert.True(t, ok)
+		assert.Equal(t, Int64Value(3), value)
+	})
+
+	t.Run("should fall back to metadata", func(t *testing.T) {
+		value, ok := reportErr.Attribute("filename")
+		assert.True(t, ok)
+		assert.Equal(t, StringValue("text.txt"), value)
+	})
+
+	t.Run("should return false for missing keys", func(t *testing.T) {
+		_, ok := reportErr.Attribute("missing")
+		assert.False(t, ok)
+	})
+}
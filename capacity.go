@@ -0,0 +1,131 @@
+package yeterr
+
+// OverflowPolicy defines what a report with limited capacity does when an error is added to a full report.
+type OverflowPolicy int
+
+const (
+	// OverflowDropOldest removes the oldest item to make room for the added error. This is the default.
+	OverflowDropOldest OverflowPolicy = iota
+	// OverflowDropNewest removes the most recently added item to make room for the added error.
+	OverflowDropNewest
+	// OverflowReject keeps the items of the report and drops the added error.
+	OverflowReject
+)
+
+// WithCapacity limits the number of items of the report. When the report is full, the overflow policy decides which
+// error is dropped. The fatal error is never dropped. A capacity of zero or less means unlimited.
+func WithCapacity(capacity int, policy OverflowPolicy) ReportOption {
+	return func(s *SimpleReport) {
+		s.capacity = capacity
+		s.overflowPolicy = policy
+	}
+}
+
+// Dropped returns the number of errors which were dropped because the report was full or their flags were rejected
+// by the catalog. Scoped views and reports which are not implemented by this package return zero.
+func Dropped(report Report) int {
+	dropped := 0
+	if r, ok := report.(reader); ok {
+		r.read(func(s *SimpleReport) {
+			dropped = s.dropped
+		})
+	}
+
+	return dropped
+}
+
+// isFull returns true if the report has a capacity and reached it.
+func (s *SimpleReport) isFull() bool {
+	return s.capacity > 0 && len(s.elements) >= s.capacity
+}
+
+// index returns the index in the ring buffer of the item at the position, counted from the oldest item.
+func (s *SimpleReport) index(position int) int {
+	return (s.head + position) % len(s.elements)
+}
+
+// items returns the items from the oldest to the newest. It returns the ring buffer itself as long as it did not wrap
+// around.
+func (s *SimpleReport) items() []ReportError {
+	if s.head == 0 {
+		return s.elements
+	}
+
+	items := make([]ReportError, 0, len(s.elements))
+	items = append(items, s.elements[s.head:]...)
+	return append(items, s.elements[:s.head]...)
+}
+
+// makeRoom evicts an item according to the overflow policy, so the element can be added. It returns the index in the
+// ring buffer where the element has to be stored, or false if the element has to be dropped instead. An item which
+// is the fatal error is never evicted, it takes the place of the evicted item instead.
+func (s *SimpleReport) makeRoom(element ReportError) (int, bool) {
+	if s.overflowPolicy == OverflowReject && !element.Fatal {
+		return 0, false
+	}
+
+	count := len(s.elements)
+	position := -1
+	for i := 0; i < count; i++ {
+		candidate := i
+		if s.overflowPolicy == OverflowDropNewest {
+			candidate = count - 1 - i
+		}
+
+		if !s.elements[s.index(candidate)].Fatal {
+			position = candidate
+			break
+		}
+	}
+
+	if position < 0 {
+		return 0, false
+	}
+
+	evicted := s.elements[s.index(position)]
+	s.dropped += evicted.occurrences()
+	s.addCount(-evicted.occurrences())
+	s.countFlags(evicted, -evicted.occurrences())
+	s.unindexFingerprint(s.index(position))
+
+	if s.overflowPolicy == OverflowDropNewest {
+		for ; position < count-1; position++ {
+			s.move(s.index(position+1), s.index(position))
+		}
+
+		return s.index(count - 1), true
+	}
+
+	for ; position > 0; position-- {
+		s.move(s.index(position-1), s.index(position))
+	}
+
+	index := s.head
+	s.head = s.index(1)
+	return index, true
+}
+
+// move moves the item of the ring buffer to another index and keeps the fingerprint index up to date.
+func (s *SimpleReport) move(from int, to int) {
+	s.elements[to] = s.elements[from]
+	if s.fingerprints == nil {
+		return
+	}
+
+	fingerprint := s.fingerprint(s.elements[to])
+	if current, ok := s.fingerprints[fingerprint]; ok && current == from {
+		s.fingerprints[fingerprint] = to
+	}
+}
+
+// unindexFingerprint removes the item at the index of the ring buffer from the fingerprint index.
+func (s *SimpleReport) unindexFingerprint(index int) {
+	if s.fingerprints == nil {
+		return
+	}
+
+	fingerprint := s.fingerprint(s.elements[index])
+	if current, ok := s.fingerprints[fingerprint]; ok && current == index {
+		delete(s.fingerprints, fingerprint)
+	}
+}
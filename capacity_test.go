@@ -0,0 +1,181 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// messages returns the error messages of all items of the report.
+func messages(report Report) []string {
+	reportMessages := make([]string, 0, UniqueCount(report))
+	for _, element := range report.AllErrors() {
+		reportMessages = append(reportMessages, element.Error())
+	}
+
+	return reportMessages
+}
+
+// addNumberedErrors adds errors with the messages "error <from>" to "error <to>".
+func addNumberedErrors(report Report, from int, to int) {
+	for i := from; i <= to; i++ {
+		report.AddError(fmt.Errorf("error %d", i), nil)
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	t.Run("should not limit the report by default", func(t *testing.T) {
+		report := NewSimpleReport()
+		addNumberedErrors(report, 1, 100)
+
+		assert.Equal(t, 100, report.Count())
+		assert.Equal(t, 0, Dropped(report))
+	})
+
+	t.Run("should drop the oldest items", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropOldest))
+		addNumberedErrors(report, 1, 5)
+
+		assert.Equal(t, []string{"error 3", "error 4", "error 5"}, messages(report))
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should drop the newest items", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropNewest))
+		addNumberedErrors(report, 1, 5)
+
+		assert.Equal(t, []string{"error 1", "error 2", "error 5"}, messages(report))
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should reject added errors", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowReject))
+		addNumberedErrors(report, 1, 5)
+
+		assert.Equal(t, []string{"error 1", "error 2", "error 3"}, messages(report))
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should mention dropped errors in the error message", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(2, OverflowDropOldest))
+		addNumberedErrors(report, 1, 5)
+
+		assert.Equal(t, "report contains 2 error(s), 3 error(s) dropped", report.Error())
+	})
+
+	t.Run("should keep the fatal error when dropping the oldest items", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropOldest))
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 1, 5)
+
+		assert.Equal(t, []string{errIOError.Error(), "error 4", "error 5"}, messages(report))
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+	})
+
+	t.Run("should keep the fatal error when dropping the newest items", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropNewest))
+		addNumberedErrors(report, 1, 2)
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 3, 4)
+
+		assert.Equal(t, []string{"error 1", errIOError.Error(), "error 4"}, messages(report))
+	})
+
+	t.Run("should not reject the first fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowReject))
+		addNumberedErrors(report, 1, 3)
+		report.AddFatalError(errIOError, nil)
+		report.AddFatalError(errWriteError, nil)
+
+		assert.Equal(t, []string{"error 2", "error 3", errIOError.Error()}, messages(report))
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should drop errors when the only item is the fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(1, OverflowDropOldest))
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 1, 2)
+
+		assert.Equal(t, []string{errIOError.Error()}, messages(report))
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should count occurrences of dropped deduplicated items", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(1, OverflowDropOldest), WithDeduplication(nil))
+		report.AddError(errReadError, nil)
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+		report.AddError(errWriteError, nil)
+
+		assert.Equal(t, []string{errWriteError.Error()}, messages(report))
+		assert.Equal(t, 2, report.Count())
+		assert.Equal(t, 2, Dropped(report))
+	})
+
+	t.Run("should keep the order of items when the ring buffer wraps around", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropOldest))
+		addNumberedErrors(report, 1, 3)
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 4, 8)
+
+		assert.Equal(t, []string{errIOError.Error(), "error 7", "error 8"}, messages(report))
+		assert.Equal(t, errIOError.Error(), report.FirstError().Error())
+		assert.Equal(t, "error 8", report.LastError().Error())
+		assert.Equal(t, []error{errIOError, errors.New("error 7"), errors.New("error 8")}, report.ToErrorSlice())
+	})
+
+	t.Run("should keep the fingerprint index of evicted and moved items up to date", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(3, OverflowDropOldest), WithDeduplication(nil))
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 1, 4)
+		addNumberedErrors(report, 3, 4)
+		report.AddFatalError(errIOError, nil)
+		addNumberedErrors(report, 1, 1)
+
+		simpleReport := report.(*SimpleReport)
+		assert.Len(t, simpleReport.fingerprints, 3)
+		assert.Equal(t, []string{errIOError.Error(), "error 4", "error 1"}, messages(report))
+		assert.Equal(t, 2, report.AllErrors()[0].Occurrences)
+		assert.Equal(t, 2, report.AllErrors()[1].Occurrences)
+		assert.Equal(t, 4, Dropped(report))
+
+		for fingerprint, index := range simpleReport.fingerprints {
+			assert.Equal(t, fingerprint, simpleReport.fingerprint(simpleReport.elements[index]))
+		}
+	})
+
+	t.Run("should keep the capacity for filtered reports", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(2, OverflowReject))
+		filteredReport := report.FilterErrorsByFlag(ErrorFlagNone)
+		addNumberedErrors(filteredReport, 1, 3)
+
+		assert.Equal(t, 2, filteredReport.Count())
+		assert.Equal(t, 1, Dropped(filteredReport))
+	})
+
+	t.Run("should serialize the dropped counter", func(t *testing.T) {
+		report := NewSimpleReport(WithCapacity(1, OverflowReject))
+		addNumberedErrors(report, 1, 3)
+
+		data, err := json.Marshal(report)
+		require.NoError(t, err)
+
+		decodedReport := NewSimpleReport()
+		require.NoError(t, json.Unmarshal(data, decodedReport))
+		assert.Equal(t, 2, Dropped(decodedReport))
+	})
+}
+
+func TestConcurrentReport_Dropped(t *testing.T) {
+	report := NewConcurrentReport(WithCapacity(10, OverflowDropOldest))
+	addNumberedErrors(report, 1, 15)
+
+	assert.Equal(t, 10, report.Count())
+	assert.Equal(t, 5, Dropped(report))
+	assert.Equal(t, "error 6", report.FirstError().Error())
+}
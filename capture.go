@@ -0,0 +1,121 @@
+package yeterr
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// packagePath is used to skip the frames of this module when capturing callers.
+const packagePath = "github.com/pvormste/yeterr"
+
+// maxStackDepth limits the number of frames captured for a full stack.
+const maxStackDepth = 64
+
+// CaptureMode defines which call site information a report captures for every added error item.
+type CaptureMode int
+
+const (
+	// CaptureNone does not capture any call site information. This is the default.
+	CaptureNone CaptureMode = iota
+	// CaptureCaller captures the file, line and function which added the error item.
+	CaptureCaller
+	// CaptureStack captures the full stack of the call which added the error item.
+	CaptureStack
+)
+
+// Frame is a single frame of a captured call stack.
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// String returns the frame in the format "function (file:line)".
+func (f Frame) String() string {
+	return fmt.Sprintf("%s (%s:%d)", f.Function, f.File, f.Line)
+}
+
+// WithCapture sets the capture mode of the report. Capturing is off by default, so hot paths do not pay for it.
+func WithCapture(mode CaptureMode) ReportOption {
+	return func(s *SimpleReport) {
+		s.captureMode = mode
+	}
+}
+
+// CaptureFrames returns the call site information which the report captures for its items: the caller for
+// CaptureCaller and CaptureStack and the stack for CaptureStack only. Both are nil if the report does not capture
+// call sites. skip is the number of frames to skip, zero is the caller of CaptureFrames. It captures the call site in
+// advance, e.g. for an item which is added later by another goroutine.
+func CaptureFrames(report Report, skip int) (*Frame, []Frame) {
+	for {
+		scoped, ok := report.(*scopedReport)
+		if !ok {
+			break
+		}
+
+		report = scoped.parent
+	}
+
+	mode := CaptureNone
+	if r, ok := report.(reader); ok {
+		r.read(func(s *SimpleReport) {
+			mode = s.captureMode
+		})
+	}
+
+	return captureFrames(mode, skip+1, isNoFrame)
+}
+
+// captureFrames returns the caller and, for CaptureStack, the stack of the call stack according to the mode. The
+// first skip frames after the caller of captureFrames and all frames before the first frame which is not skipped by
+// skipFrame are left out.
+func captureFrames(mode CaptureMode, skip int, skipFrame func(frame runtime.Frame) bool) (*Frame, []Frame) {
+	if mode == CaptureNone {
+		return nil, nil
+	}
+
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+	callersFrames := runtime.CallersFrames(pcs[:n])
+
+	var frames []Frame
+	for {
+		frame, more := callersFrames.Next()
+		if len(frames) > 0 || !skipFrame(frame) {
+			frames = append(frames, Frame{
+				Function: frame.Function,
+				File:     frame.File,
+				Line:     frame.Line,
+			})
+
+			if mode == CaptureCaller {
+				break
+			}
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if len(frames) == 0 {
+		return nil, nil
+	}
+
+	if mode == CaptureStack {
+		return &frames[0], frames
+	}
+
+	return &frames[0], nil
+}
+
+// isNoFrame returns false for every frame, so no frame is skipped.
+func isNoFrame(runtime.Frame) bool {
+	return false
+}
+
+// isInternalFrame returns true if the frame belongs to this module.
+func isInternalFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePath+".") || strings.HasPrefix(frame.Function, packagePath+"/")
+}
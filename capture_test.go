@@ -0,0 +1,122 @@
+package yeterr_test
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+)
+
+// The capture tests live in an external test package, because the frames of the yeterr package itself are never
+// captured as callers.
+const flagReadError yeterr.ErrorFlag = "read_error"
+
+var errReadError = errors.New("this simulates a read error")
+
+func TestWithCapture(t *testing.T) {
+	t.Run("should not capture anything by default", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddError(errReadError, nil)
+
+		assert.Nil(t, report.FirstError().Caller)
+		assert.Nil(t, report.FirstError().Stack)
+	})
+
+	t.Run("should capture only the caller", func(t *testing.T) {
+		report := yeterr.NewSimpleReport(yeterr.WithCapture(yeterr.CaptureCaller))
+		report.AddError(errReadError, nil)
+
+		caller := report.FirstError().Caller
+		require.NotNil(t, caller)
+		assert.Equal(t, "capture_test.go", filepath.Base(caller.File))
+		assert.True(t, strings.HasPrefix(caller.Function, "github.com/pvormste/yeterr_test.TestWithCapture"))
+		assert.Greater(t, caller.Line, 0)
+		assert.Nil(t, report.FirstError().Stack)
+	})
+
+	t.Run("should capture the full stack", func(t *testing.T) {
+		report := yeterr.NewSimpleReport(yeterr.WithCapture(yeterr.CaptureStack))
+		report.AddFlaggedFatalError(errReadError, nil, flagReadError)
+
+		fatalError := report.FatalError()
+		require.NotNil(t, fatalError.Caller)
+		require.Greater(t, len(fatalError.Stack), 1)
+		assert.Equal(t, *fatalError.Caller, fatalError.Stack[0])
+		assert.Equal(t, "capture_test.go", filepath.Base(fatalError.Stack[0].File))
+	})
+
+	t.Run("should skip frames of the concurrent report", func(t *testing.T) {
+		report := yeterr.NewConcurrentReport(yeterr.WithCapture(yeterr.CaptureCaller))
+		report.AddWithSeverity(errReadError, nil, yeterr.SeverityWarning)
+
+		caller := report.FirstError().Caller
+		require.NotNil(t, caller)
+		assert.Equal(t, "capture_test.go", filepath.Base(caller.File))
+	})
+
+	t.Run("should keep the capture mode for filtered reports", func(t *testing.T) {
+		report := yeterr.NewSimpleReport(yeterr.WithCapture(yeterr.CaptureCaller))
+		filteredReport := report.FilterErrorsByFlag(flagReadError)
+		filteredReport.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.NotNil(t, filteredReport.FirstError().Caller)
+	})
+
+	t.Run("should serialize caller and stack", func(t *testing.T) {
+		report := yeterr.NewSimpleReport(yeterr.WithCapture(yeterr.CaptureStack))
+		report.AddError(errReadError, nil)
+
+		data, err := json.Marshal(report.FirstError())
+		require.NoError(t, err)
+
+		var decoded yeterr.ReportError
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, report.FirstError().Caller, decoded.Caller)
+		assert.Equal(t, report.FirstError().Stack, decoded.Stack)
+	})
+}
+
+func TestCaptureFrames(t *testing.T) {
+	t.Run("should not capture anything without capture mode", func(t *testing.T) {
+		caller, stack := yeterr.CaptureFrames(yeterr.NewSimpleReport(), 0)
+
+		assert.Nil(t, caller)
+		assert.Nil(t, stack)
+	})
+
+	t.Run("should capture the caller", func(t *testing.T) {
+		report := yeterr.NewConcurrentReport(yeterr.WithCapture(yeterr.CaptureCaller))
+		caller, stack := yeterr.CaptureFrames(report.Child("import"), 0)
+
+		require.NotNil(t, caller)
+		assert.True(t, strings.HasPrefix(caller.Function, "github.com/pvormste/yeterr_test.TestCaptureFrames"))
+		assert.Nil(t, stack)
+	})
+
+	t.Run("should skip frames and capture the stack", func(t *testing.T) {
+		report := yeterr.NewSimpleReport(yeterr.WithCapture(yeterr.CaptureStack))
+		caller, stack := func() (*yeterr.Frame, []yeterr.Frame) {
+			return yeterr.CaptureFrames(report, 1)
+		}()
+
+		require.NotNil(t, caller)
+		assert.Equal(t, "github.com/pvormste/yeterr_test.TestCaptureFrames.func3", caller.Function)
+		assert.Equal(t, *caller, stack[0])
+	})
+}
+
+func TestFrame_String(t *testing.T) {
+	frame := yeterr.Frame{
+		Function: "main.main",
+		File:     "/src/main.go",
+		Line:     12,
+	}
+
+	assert.Equal(t, "main.main (/src/main.go:12)", frame.String())
+}
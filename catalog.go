@@ -0,0 +1,190 @@
+package yeterr
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrorFlagUnknown is added to error items with flags which are not declared in the catalog of the report, if the
+// report marks unknown flags.
+const ErrorFlagUnknown ErrorFlag = "unknown_flag"
+
+// FlagDefinition declares the meaning of a flag.
+type FlagDefinition struct {
+	Flag        ErrorFlag
+	Description string
+	// Severity is the default severity of error items with the flag. It is only applied to error items with
+	// SeverityError, which is the severity of error items without an explicit severity.
+	Severity    Severity
+	Code        string
+	NumericCode int
+	HTTPStatus  int
+	Retryable   bool
+}
+
+// Catalog is a registry of flag definitions. It is safe for concurrent use.
+type Catalog struct {
+	mu          sync.RWMutex
+	definitions map[ErrorFlag]FlagDefinition
+}
+
+// NewCatalog creates a catalog with the definitions. It returns an error if a definition can not be registered.
+func NewCatalog(definitions ...FlagDefinition) (*Catalog, error) {
+	catalog := &Catalog{
+		definitions: map[ErrorFlag]FlagDefinition{},
+	}
+
+	for _, definition := range definitions {
+		if err := catalog.Register(definition); err != nil {
+			return nil, err
+		}
+	}
+
+	return catalog, nil
+}
+
+// Register adds the definition to the catalog. It returns an error if the flag is empty or already registered or the
+// severity is not declared.
+func (c *Catalog) Register(definition FlagDefinition) error {
+	if definition.Flag == "" {
+		return fmt.Errorf("yeterr: flag definition without flag")
+	}
+
+	if _, ok := severityNames[definition.Severity]; !ok {
+		return fmt.Errorf("yeterr: flag %q has unknown severity %d", definition.Flag, int(definition.Severity))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.definitions[definition.Flag]; ok {
+		return fmt.Errorf("yeterr: flag %q is already registered", definition.Flag)
+	}
+
+	c.definitions[definition.Flag] = definition
+	return nil
+}
+
+// Lookup returns the definition of the flag. It returns false if the flag is not registered.
+func (c *Catalog) Lookup(flag ErrorFlag) (FlagDefinition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	definition, ok := c.definitions[flag]
+	return definition, ok
+}
+
+// Definition returns the definition of the first registered flag of the error item. It returns false if none of its
+// flags is registered.
+func (c *Catalog) Definition(reportErr ReportError) (FlagDefinition, bool) {
+	for _, flag := range reportErr.AllFlags() {
+		if definition, ok := c.Lookup(flag); ok {
+			return definition, true
+		}
+	}
+
+	return FlagDefinition{}, false
+}
+
+// Definitions returns all definitions ordered by flag.
+func (c *Catalog) Definitions() []FlagDefinition {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	definitions := make([]FlagDefinition, 0, len(c.definitions))
+	for _, definition := range c.definitions {
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Flag < definitions[j].Flag
+	})
+
+	return definitions
+}
+
+// UnknownFlags returns the flags of the error item which are not registered. The default flag ErrorFlagNone and
+// ErrorFlagUnknown are always known.
+func (c *Catalog) UnknownFlags(reportErr ReportError) []ErrorFlag {
+	var unknownFlags []ErrorFlag
+	for _, flag := range reportErr.AllFlags() {
+		if flag == ErrorFlagNone || flag == ErrorFlagUnknown {
+			continue
+		}
+
+		if _, ok := c.Lookup(flag); !ok {
+			unknownFlags = append(unknownFlags, flag)
+		}
+	}
+
+	return unknownFlags
+}
+
+// Retryable returns true if the report has errors and all of them have a flag with a retryable definition.
+func (c *Catalog) Retryable(report Report) bool {
+	if !report.HasErrors() {
+		return false
+	}
+
+	retryable := true
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if definition, ok := c.Definition(element); !ok || !definition.Retryable {
+				retryable = false
+				return
+			}
+		}
+	})
+
+	return retryable
+}
+
+// UnknownFlagPolicy defines what a report with a catalog does with errors which have unknown flags.
+type UnknownFlagPolicy int
+
+const (
+	// UnknownFlagAllow adds errors with unknown flags unchanged. This is the default.
+	UnknownFlagAllow UnknownFlagPolicy = iota
+	// UnknownFlagMark adds ErrorFlagUnknown as additional flag to errors with unknown flags.
+	UnknownFlagMark
+	// UnknownFlagReject drops errors with unknown flags. They are counted as dropped.
+	UnknownFlagReject
+)
+
+// WithCatalog validates added errors against the catalog. Errors with SeverityError get the default severity of the
+// definition of their flags, an error escalated to SeverityFatal is added like a fatal error. The unknown flag policy
+// decides what happens to errors with unknown flags. Rejected errors are neither checked by policies nor passed to
+// hooks.
+func WithCatalog(catalog *Catalog, policy UnknownFlagPolicy) ReportOption {
+	return func(s *SimpleReport) {
+		s.catalog = catalog
+		s.unknownFlagPolicy = policy
+	}
+}
+
+// applyCatalog validates the element against the catalog of the report. It returns false if the element is rejected.
+func (s *SimpleReport) applyCatalog(element ReportError) (ReportError, bool) {
+	if s.catalog == nil {
+		return element, true
+	}
+
+	if len(s.catalog.UnknownFlags(element)) > 0 {
+		switch s.unknownFlagPolicy {
+		case UnknownFlagReject:
+			return element, false
+		case UnknownFlagMark:
+			if !element.HasFlag(ErrorFlagUnknown) {
+				element.Flags = append(append([]ErrorFlag{}, element.Flags...), ErrorFlagUnknown)
+			}
+		}
+	}
+
+	definition, ok := s.catalog.Definition(element)
+	if ok && element.Severity == SeverityError && definition.Severity != SeverityError {
+		element.Severity = definition.Severity
+		element.Fatal = definition.Severity == SeverityFatal
+	}
+
+	return element, true
+}
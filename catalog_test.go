@@ -0,0 +1,185 @@
+package yeterr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCatalog(t *testing.T) *Catalog {
+	catalog, err := NewCatalog(
+		FlagDefinition{
+			Flag:        flagReadError,
+			Description: "a file could not be read",
+			Code:        "E_READ",
+			NumericCode: 1001,
+			HTTPStatus:  503,
+			Retryable:   true,
+		},
+		FlagDefinition{
+			Flag:        flagWriteError,
+			Description: "a file could not be written",
+			Severity:    SeverityCritical,
+			Code:        "E_WRITE",
+			NumericCode: 1002,
+		},
+		FlagDefinition{
+			Flag:        flagIOError,
+			Description: "the device failed",
+			Severity:    SeverityFatal,
+			Code:        "E_IO",
+			NumericCode: 1003,
+		},
+	)
+	require.NoError(t, err)
+
+	return catalog
+}
+
+func TestNewCatalog(t *testing.T) {
+	t.Run("should return an error for duplicate flags", func(t *testing.T) {
+		_, err := NewCatalog(FlagDefinition{Flag: flagReadError}, FlagDefinition{Flag: flagReadError})
+		assert.EqualError(t, err, `yeterr: flag "read_error" is already registered`)
+	})
+
+	t.Run("should return an error for definitions without flag", func(t *testing.T) {
+		_, err := NewCatalog(FlagDefinition{Code: "E_READ"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return an error for undeclared severities", func(t *testing.T) {
+		_, err := NewCatalog(FlagDefinition{Flag: flagReadError, Severity: Severity(42)})
+		assert.EqualError(t, err, `yeterr: flag "read_error" has unknown severity 42`)
+	})
+}
+
+func TestCatalog_Lookup(t *testing.T) {
+	catalog := newTestCatalog(t)
+
+	definition, ok := catalog.Lookup(flagReadError)
+	require.True(t, ok)
+	assert.Equal(t, "E_READ", definition.Code)
+
+	_, ok = catalog.Lookup("unknown")
+	assert.False(t, ok)
+}
+
+func TestCatalog_Definition(t *testing.T) {
+	catalog := newTestCatalog(t)
+
+	definition, ok := catalog.Definition(ReportError{Flag: "unknown", Flags: []ErrorFlag{flagWriteError, flagIOError}})
+	require.True(t, ok)
+	assert.Equal(t, flagWriteError, definition.Flag)
+
+	_, ok = catalog.Definition(ReportError{Flag: ErrorFlagNone})
+	assert.False(t, ok)
+}
+
+func TestCatalog_Definitions(t *testing.T) {
+	definitions := newTestCatalog(t).Definitions()
+
+	require.Len(t, definitions, 3)
+	assert.Equal(t, flagIOError, definitions[0].Flag)
+	assert.Equal(t, flagReadError, definitions[1].Flag)
+	assert.Equal(t, flagWriteError, definitions[2].Flag)
+}
+
+func TestCatalog_UnknownFlags(t *testing.T) {
+	catalog := newTestCatalog(t)
+
+	assert.Empty(t, catalog.UnknownFlags(ReportError{Flag: ErrorFlagNone}))
+	assert.Equal(t, []ErrorFlag{"unknown"}, catalog.UnknownFlags(ReportError{
+		Flag:  flagReadError,
+		Flags: []ErrorFlag{"unknown", ErrorFlagUnknown},
+	}))
+}
+
+func TestCatalog_Retryable(t *testing.T) {
+	catalog := newTestCatalog(t)
+	report := NewSimpleReport()
+	assert.False(t, catalog.Retryable(report))
+
+	report.AddFlaggedError(errReadError, nil, flagReadError)
+	assert.True(t, catalog.Retryable(report))
+
+	report.AddError(errWriteError, nil)
+	assert.False(t, catalog.Retryable(report))
+}
+
+func TestWithCatalog(t *testing.T) {
+	t.Run("should apply the default severity of the flag", func(t *testing.T) {
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagAllow))
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+		report.AddFlaggedWithSeverity(errWriteError, nil, flagWriteError, SeverityWarning)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, SeverityCritical, report.AllErrors()[0].Severity)
+		assert.Equal(t, SeverityWarning, report.AllErrors()[1].Severity)
+		assert.Equal(t, SeverityError, report.AllErrors()[2].Severity)
+		assert.False(t, report.HasFatalError())
+	})
+
+	t.Run("should add errors with a fatal default severity as fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagAllow))
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+
+		require.True(t, report.HasFatalError())
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+		assert.True(t, report.FirstError().Fatal)
+		assert.False(t, report.LastError().Fatal)
+	})
+
+	t.Run("should allow unknown flags", func(t *testing.T) {
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagAllow))
+		report.AddFlaggedError(errReadError, nil, "unknown")
+		report.AddError(errReadError, nil)
+
+		assert.Equal(t, 2, report.Count())
+		assert.False(t, report.FirstError().HasFlag(ErrorFlagUnknown))
+	})
+
+	t.Run("should mark unknown flags", func(t *testing.T) {
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagMark))
+		report.AddMultiFlaggedError(errReadError, nil, "unknown", flagReadError)
+		report.AddError(errReadError, nil)
+
+		assert.Equal(t, []ErrorFlag{flagReadError, ErrorFlagUnknown}, report.FirstError().Flags)
+		assert.False(t, report.LastError().HasFlag(ErrorFlagUnknown))
+	})
+
+	t.Run("should reject unknown flags", func(t *testing.T) {
+		var added int
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagReject))
+		report.OnAdd(func(ReportError) {
+			added++
+		})
+
+		report.AddFlaggedError(errReadError, nil, "unknown")
+		report.AddFlaggedFatalError(errWriteError, nil, "unknown")
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, 1, report.Count())
+		assert.Equal(t, 2, Dropped(report))
+		assert.Equal(t, 1, added)
+		assert.False(t, report.HasFatalError())
+	})
+
+	t.Run("should keep the catalog for filtered reports", func(t *testing.T) {
+		report := NewSimpleReport(WithCatalog(newTestCatalog(t), UnknownFlagReject))
+		filteredReport := report.FilterErrorsByFlag(flagReadError)
+		filteredReport.AddFlaggedError(errReadError, nil, "unknown")
+
+		assert.Equal(t, 1, Dropped(filteredReport))
+	})
+
+	t.Run("should validate errors of concurrent reports", func(t *testing.T) {
+		report := NewConcurrentReport(WithCatalog(newTestCatalog(t), UnknownFlagReject))
+		report.AddFlaggedError(errReadError, nil, "unknown")
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+
+		assert.Equal(t, 1, Dropped(report))
+		assert.True(t, report.HasFatalError())
+	})
+}
@@ -0,0 +1,21 @@
+package yeterr
+
+import (
+	"time"
+)
+
+// WithClock sets the clock which provides the time of every added error item. The default clock is time.Now.
+func WithClock(now func() time.Time) ReportOption {
+	return func(s *SimpleReport) {
+		s.clock = now
+	}
+}
+
+// now returns the current time of the report's clock.
+func (s *SimpleReport) now() time.Time {
+	if s.clock == nil {
+		return time.Now()
+	}
+
+	return s.clock()
+}
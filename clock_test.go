@@ -0,0 +1,135 @@
+package yeterr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var referenceTime = time.Date(2020, time.April, 1, 12, 0, 0, 0, time.UTC)
+
+// fixedClock returns a clock which always returns the provided time.
+func fixedClock(t time.Time) func() time.Time {
+	return func() time.Time {
+		return t
+	}
+}
+
+// steppingClock returns a clock which starts at the provided time and advances by step on every call.
+func steppingClock(start time.Time, step time.Duration) func() time.Time {
+	current := start.Add(-step)
+	return func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	t.Run("should use the current time by default", func(t *testing.T) {
+		before := time.Now()
+		report := NewSimpleReport()
+		report.AddError(errReadError, nil)
+
+		addedTime := report.FirstError().Time
+		assert.False(t, addedTime.Before(before))
+		assert.False(t, addedTime.After(time.Now()))
+	})
+
+	t.Run("should use the provided clock", func(t *testing.T) {
+		report := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+		report.AddError(errReadError, nil)
+		report.AddFatalError(errWriteError, nil)
+
+		assert.Equal(t, referenceTime, report.FirstError().Time)
+		assert.Equal(t, referenceTime, report.FatalError().Time)
+	})
+
+	t.Run("should keep the clock for filtered reports", func(t *testing.T) {
+		report := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+		filteredReport := report.FilterErrorsByFlag(flagReadError)
+		filteredReport.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, referenceTime, filteredReport.FirstError().Time)
+	})
+}
+
+func TestSimpleReport_FirstSeen_and_LastSeen(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	t.Run("should return zero time when report is empty", func(t *testing.T) {
+		assert.True(t, FirstSeen(report).IsZero())
+		assert.True(t, LastSeen(report).IsZero())
+	})
+
+	t.Run("should return the earliest and latest time", func(t *testing.T) {
+		report.elements = []ReportError{
+			{WrappedError: errReadError, Time: referenceTime.Add(time.Minute)},
+			{WrappedError: errWriteError, Time: referenceTime},
+			{WrappedError: errIOError, Time: referenceTime.Add(time.Hour)},
+		}
+
+		assert.Equal(t, referenceTime, FirstSeen(report))
+		assert.Equal(t, referenceTime.Add(time.Hour), LastSeen(report))
+	})
+}
+
+func TestSimpleReport_FilterByTimeRange(t *testing.T) {
+	report := NewSimpleReport(WithClock(steppingClock(referenceTime, time.Minute)))
+	report.AddFlaggedError(errReadError, nil, flagReadError)
+	report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+	report.AddFlaggedError(errIOError, nil, flagIOError)
+
+	t.Run("should include from and exclude to", func(t *testing.T) {
+		filteredReport := report.Filter(ByTimeRange(referenceTime, referenceTime.Add(time.Minute)))
+
+		require.Equal(t, 1, filteredReport.Count())
+		assert.Equal(t, errReadError, filteredReport.FirstError().Unwrap())
+		assert.False(t, filteredReport.HasFatalError())
+	})
+
+	t.Run("should carry over the fatal error when it is in range", func(t *testing.T) {
+		filteredReport := report.Filter(ByTimeRange(referenceTime.Add(time.Minute), time.Time{}))
+
+		assert.Equal(t, 2, filteredReport.Count())
+		require.True(t, filteredReport.HasFatalError())
+		assert.Equal(t, errWriteError, filteredReport.FatalError().Unwrap())
+	})
+
+	t.Run("should return all items for an open range", func(t *testing.T) {
+		assert.Equal(t, 3, report.Filter(ByTimeRange(time.Time{}, time.Time{})).Count())
+	})
+}
+
+func TestSimpleReport_ErrorsByTime(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	t.Run("should return an empty slice when report is empty", func(t *testing.T) {
+		assert.Equal(t, []ReportError{}, ErrorsByTime(report))
+	})
+
+	t.Run("should return items ordered by time and keep the order of equal times", func(t *testing.T) {
+		late := ReportError{WrappedError: errReadError, Time: referenceTime.Add(time.Hour)}
+		early := ReportError{WrappedError: errWriteError, Time: referenceTime}
+		alsoEarly := ReportError{WrappedError: errIOError, Time: referenceTime}
+		report.elements = []ReportError{late, early, alsoEarly}
+
+		assert.Equal(t, []ReportError{early, alsoEarly, late}, ErrorsByTime(report))
+		assert.Equal(t, []ReportError{late, early, alsoEarly}, report.AllErrors())
+	})
+}
+
+func TestConcurrentReport_Time(t *testing.T) {
+	report := NewConcurrentReport(WithClock(steppingClock(referenceTime, time.Second)))
+	report.AddError(errReadError, nil)
+	report.AddError(errWriteError, nil)
+
+	assert.Equal(t, referenceTime, FirstSeen(report))
+	assert.Equal(t, referenceTime.Add(time.Second), LastSeen(report))
+	assert.Len(t, ErrorsByTime(report), 2)
+
+	filteredReport := report.Filter(ByTimeRange(referenceTime.Add(time.Second), time.Time{}))
+	assert.IsType(t, &ConcurrentReport{}, filteredReport)
+	assert.Equal(t, 1, filteredReport.Count())
+}
@@ -0,0 +1,305 @@
+package yeterr
+
+import (
+	"sync"
+	"time"
+)
+
+// ConcurrentReport is a report implementation which is safe for concurrent use by multiple goroutines. It guards a
+// SimpleReport with a read-write mutex. Fatal errors are still first-fatal-wins: the fatal error is always the fatal
+// error which was added first in the order of AllErrors. The zero value is an empty report without options, ready to
+// use.
+type ConcurrentReport struct {
+	mu     sync.RWMutex
+	once   sync.Once
+	report *SimpleReport
+}
+
+// NewConcurrentReport creates a new empty error report which is safe for concurrent use.
+func NewConcurrentReport(options ...ReportOption) Report {
+	return wrapConcurrent(NewSimpleReport(options...))
+}
+
+// IsEmpty returns true if the report does not have any item.
+func (c *ConcurrentReport) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().IsEmpty()
+}
+
+// HasErrors returns true if the report does have at least one item.
+func (c *ConcurrentReport) HasErrors() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().HasErrors()
+}
+
+// HasFatalError returns true if the report does have a fatal error. There can only exist one fatal error.
+func (c *ConcurrentReport) HasFatalError() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().HasFatalError()
+}
+
+// Count returns the number of errors added to the report. For deduplicating reports this includes all duplicates.
+func (c *ConcurrentReport) Count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().Count()
+}
+
+// AddError adds an error item into the report. The error item gets a default flag assigned.
+func (c *ConcurrentReport) AddError(err error, metadata ErrorMetadata) {
+	c.add(func(report *SimpleReport) {
+		report.AddError(err, metadata)
+	})
+}
+
+// AddFatalError adds a fatal error to the report. Only the first fatal error will be available via FatalError.
+func (c *ConcurrentReport) AddFatalError(err error, metadata ErrorMetadata) {
+	c.add(func(report *SimpleReport) {
+		report.AddFatalError(err, metadata)
+	})
+}
+
+// AddFlaggedError adds an error with a provided flag to the report.
+func (c *ConcurrentReport) AddFlaggedError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	c.add(func(report *SimpleReport) {
+		report.AddFlaggedError(err, metadata, flag)
+	})
+}
+
+// AddFlaggedFatalError adds a fatal error with a provided flag to the report. Only the first fatal error will be
+// available via FatalError.
+func (c *ConcurrentReport) AddFlaggedFatalError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	c.add(func(report *SimpleReport) {
+		report.AddFlaggedFatalError(err, metadata, flag)
+	})
+}
+
+// AddMultiFlaggedError adds an error with multiple flags to the report. The first flag becomes the flag of the error
+// item, the others become additional flags.
+func (c *ConcurrentReport) AddMultiFlaggedError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	c.add(func(report *SimpleReport) {
+		report.AddMultiFlaggedError(err, metadata, flags...)
+	})
+}
+
+// AddMultiFlaggedFatalError adds a fatal error with multiple flags to the report. Only the first added fatal error
+// will be available via FatalError.
+func (c *ConcurrentReport) AddMultiFlaggedFatalError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	c.add(func(report *SimpleReport) {
+		report.AddMultiFlaggedFatalError(err, metadata, flags...)
+	})
+}
+
+// AddWithSeverity adds an error item with a provided severity into the report. An error item with SeverityFatal is
+// added like a fatal error.
+func (c *ConcurrentReport) AddWithSeverity(err error, metadata ErrorMetadata, severity Severity) {
+	c.add(func(report *SimpleReport) {
+		report.AddWithSeverity(err, metadata, severity)
+	})
+}
+
+// AddFlaggedWithSeverity adds an error item with a provided flag and severity into the report. An error item with
+// SeverityFatal is added like a fatal error.
+func (c *ConcurrentReport) AddFlaggedWithSeverity(err error, metadata ErrorMetadata, flag ErrorFlag, severity Severity) {
+	c.add(func(report *SimpleReport) {
+		report.AddFlaggedWithSeverity(err, metadata, flag, severity)
+	})
+}
+
+// addReportError adds the prepared error item into the report.
+func (c *ConcurrentReport) addReportError(reportErr ReportError) {
+	c.add(func(report *SimpleReport) {
+		report.addReportError(reportErr)
+	})
+}
+
+// AllErrors returns a snapshot of all items as slice. Later added errors will not show up in the snapshot.
+func (c *ConcurrentReport) AllErrors() []ReportError {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return append([]ReportError{}, c.simple().AllErrors()...)
+}
+
+// FirstError returns a copy of the first error in the report. Nil if the report is empty.
+func (c *ConcurrentReport) FirstError() *ReportError {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return copyReportError(c.simple().FirstError())
+}
+
+// LastError returns a copy of the last error of the report. Nil if the report is empty.
+func (c *ConcurrentReport) LastError() *ReportError {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return copyReportError(c.simple().LastError())
+}
+
+// Filter returns only those error items as new concurrent report which match the predicate. The predicate must not
+// call methods of the report.
+func (c *ConcurrentReport) Filter(predicate Predicate) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().Filter(predicate))
+}
+
+// FilterErrorsByFlag returns only those error items as new concurrent report which do have the specific flag.
+func (c *ConcurrentReport) FilterErrorsByFlag(flag ErrorFlag) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().FilterErrorsByFlag(flag))
+}
+
+// FilterErrorsByFlags returns only those error items as new concurrent report which do have one of the specific flags.
+func (c *ConcurrentReport) FilterErrorsByFlags(flags ...ErrorFlag) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().FilterErrorsByFlags(flags...))
+}
+
+// ExcludeErrorsByFlag returns all error items as new concurrent report which do not have the excluded flag.
+func (c *ConcurrentReport) ExcludeErrorsByFlag(flag ErrorFlag) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().ExcludeErrorsByFlag(flag))
+}
+
+// ExcludeErrorsByFlags returns all error items as new concurrent report which do not have one of the excluded flags.
+func (c *ConcurrentReport) ExcludeErrorsByFlags(flags ...ErrorFlag) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().ExcludeErrorsByFlags(flags...))
+}
+
+// FilterErrorsByFlagMatch returns only those error items as new concurrent report whose flags match the provided
+// flags according to the match mode.
+func (c *ConcurrentReport) FilterErrorsByFlagMatch(match FlagMatch, flags ...ErrorFlag) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().FilterErrorsByFlagMatch(match, flags...))
+}
+
+// FilterBySeverityAtLeast returns only those error items as new concurrent report which do have the provided severity
+// or a more serious one.
+func (c *ConcurrentReport) FilterBySeverityAtLeast(severity Severity) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().FilterBySeverityAtLeast(severity))
+}
+
+// FilterByTimeRange returns only those error items as new concurrent report which were added in the time range. The
+// range includes from and excludes to. A zero from or to leaves the range open on that side.
+func (c *ConcurrentReport) FilterByTimeRange(from time.Time, to time.Time) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return wrapConcurrent(c.simple().FilterByTimeRange(from, to))
+}
+
+// FatalError returns a copy of the first added fatal error. Nil if there does not exist one.
+func (c *ConcurrentReport) FatalError() *ReportError {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return copyReportError(c.simple().FatalError())
+}
+
+// ToErrorSlice returns all errors items as an error slice.
+func (c *ConcurrentReport) ToErrorSlice() []error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().ToErrorSlice()
+}
+
+// Unwrap returns a snapshot of all error items of the report, so errors.Is and errors.As do inspect each wrapped error.
+func (c *ConcurrentReport) Unwrap() []error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().Unwrap()
+}
+
+// Error implements the error interface.
+func (c *ConcurrentReport) Error() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().Error()
+}
+
+// read calls fn with the wrapped report while holding the read lock.
+func (c *ConcurrentReport) read(fn func(s *SimpleReport)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	fn(c.simple())
+}
+
+// wrap wraps the created report into a new concurrent report.
+func (c *ConcurrentReport) wrap(report *SimpleReport) Report {
+	return wrapConcurrent(report)
+}
+
+// wrapConcurrent wraps a report created by a SimpleReport into a ConcurrentReport. The hooks of the wrapped report are
+// deferred, so they run after the lock was released.
+func wrapConcurrent(report Report) Report {
+	simpleReport := report.(*SimpleReport)
+	simpleReport.deferHooks = true
+
+	return &ConcurrentReport{
+		report: simpleReport,
+	}
+}
+
+// simple returns the wrapped report. The wrapped report of a zero value ConcurrentReport is created on first use.
+func (c *ConcurrentReport) simple() *SimpleReport {
+	c.once.Do(func() {
+		if c.report == nil {
+			c.report = NewSimpleReport().(*SimpleReport)
+			c.report.deferHooks = true
+		}
+	})
+
+	return c.report
+}
+
+// add calls add with the wrapped report while holding the write lock. The hooks of the added items run after the lock
+// was released, so hooks can use the report.
+func (c *ConcurrentReport) add(add func(report *SimpleReport)) {
+	c.mu.Lock()
+	add(c.simple())
+	pendingHooks := c.simple().pendingHooks
+	c.simple().pendingHooks = nil
+	c.mu.Unlock()
+
+	for _, run := range pendingHooks {
+		run()
+	}
+}
+
+// copyReportError returns a pointer to a copy of the provided report error, so it can not be changed by later writes.
+func copyReportError(reportError *ReportError) *ReportError {
+	if reportError == nil {
+		return nil
+	}
+
+	reportErrorCopy := *reportError
+	return &reportErrorCopy
+}
@@ -0,0 +1,284 @@
+package yeterr
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const concurrentWorkers = 50
+
+func TestConcurrentReport_ZeroValue(t *testing.T) {
+	t.Run("should be usable without constructor", func(t *testing.T) {
+		var report ConcurrentReport
+		assert.True(t, report.IsEmpty())
+		assert.Nil(t, report.FatalError())
+
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		assert.Equal(t, 1, report.Count())
+	})
+
+	t.Run("should be usable by multiple goroutines without constructor", func(t *testing.T) {
+		report := &ConcurrentReport{}
+
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				report.AddError(errReadError, nil)
+				_ = report.Count()
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, concurrentWorkers, report.Count())
+	})
+}
+
+func TestConcurrentReport_AddFlaggedError(t *testing.T) {
+	report := NewConcurrentReport()
+
+	t.Run("should collect all errors added concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				report.AddFlaggedError(fmt.Errorf("error %d", i), ErrorMetadata{"worker": fmt.Sprint(i)}, flagReadError)
+				report.AddError(errWriteError, nil)
+			}(i)
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, 2*concurrentWorkers, report.Count())
+		assert.Equal(t, concurrentWorkers, report.FilterErrorsByFlag(flagReadError).Count())
+		assert.False(t, report.HasFatalError())
+	})
+}
+
+func TestConcurrentReport_AddFlaggedFatalError(t *testing.T) {
+	report := NewConcurrentReport()
+
+	t.Run("should keep the first added fatal error when fatal errors are added concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				report.AddFlaggedFatalError(fmt.Errorf("fatal %d", i), nil, flagIOError)
+			}(i)
+		}
+
+		wg.Wait()
+
+		require.Equal(t, concurrentWorkers, report.Count())
+		assert.True(t, report.HasFatalError())
+		assert.Equal(t, report.FirstError(), report.FatalError())
+	})
+
+	t.Run("should not overwrite an existing fatal error", func(t *testing.T) {
+		firstFatalError := report.FatalError()
+		report.AddFatalError(errReadError, nil)
+
+		assert.Equal(t, firstFatalError, report.FatalError())
+	})
+}
+
+func TestConcurrentReport_AllErrors(t *testing.T) {
+	report := NewConcurrentReport()
+
+	t.Run("should return an empty slice when there are no errors", func(t *testing.T) {
+		assert.Equal(t, []ReportError{}, report.AllErrors())
+	})
+
+	t.Run("should return a snapshot which is not affected by later writes", func(t *testing.T) {
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		snapshot := report.AllErrors()
+
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+
+		assert.Len(t, snapshot, 1)
+		assert.Equal(t, errReadError, snapshot[0].Unwrap())
+		assert.Equal(t, 2, report.Count())
+	})
+
+	t.Run("should return consistent snapshots while errors are added concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				report.AddError(errIOError, nil)
+			}()
+			go func() {
+				defer wg.Done()
+				snapshot := report.AllErrors()
+				assert.GreaterOrEqual(t, len(snapshot), 2)
+				assert.Equal(t, errReadError, snapshot[0].Unwrap())
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, concurrentWorkers+2, report.Count())
+	})
+}
+
+func TestConcurrentReport_FirstError_and_LastError(t *testing.T) {
+	report := NewConcurrentReport()
+
+	t.Run("should return nil when there are no errors", func(t *testing.T) {
+		assert.Nil(t, report.FirstError())
+		assert.Nil(t, report.LastError())
+	})
+
+	t.Run("should return copies of the first and last element", func(t *testing.T) {
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+
+		firstError := report.FirstError()
+		firstError.Flag = flagIOError
+
+		assert.Equal(t, flagReadError, report.FirstError().Flag)
+		assert.Equal(t, errWriteError, report.LastError().Unwrap())
+	})
+}
+
+func TestConcurrentReport_Filter(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddFlaggedError(errReadError, nil, flagReadError)
+	report.AddFlaggedError(errWriteError, nil, flagWriteError)
+	report.AddFlaggedFatalError(errIOError, nil, flagIOError)
+
+	t.Run("should return concurrent reports from filter functions", func(t *testing.T) {
+		filteredReports := []Report{
+			report.FilterErrorsByFlag(flagReadError),
+			report.FilterErrorsByFlags(flagReadError, flagWriteError),
+			report.ExcludeErrorsByFlag(flagIOError),
+			report.ExcludeErrorsByFlags(flagWriteError, flagIOError),
+		}
+
+		for _, filteredReport := range filteredReports {
+			assert.IsType(t, &ConcurrentReport{}, filteredReport)
+			assert.False(t, filteredReport.HasFatalError())
+		}
+
+		assert.Equal(t, 1, filteredReports[0].Count())
+		assert.Equal(t, 2, filteredReports[1].Count())
+		assert.Equal(t, 2, filteredReports[2].Count())
+		assert.Equal(t, 1, filteredReports[3].Count())
+	})
+
+	t.Run("should return a concurrent report from a predicate filter", func(t *testing.T) {
+		filteredReport := report.Filter(Or(ByFlag(flagReadError), ByFlag(flagIOError)))
+
+		assert.IsType(t, &ConcurrentReport{}, filteredReport)
+		assert.Equal(t, 2, filteredReport.Count())
+		assert.True(t, filteredReport.HasFatalError())
+	})
+
+	t.Run("should carry the fatal error over to the filtered report", func(t *testing.T) {
+		filteredReport := report.FilterErrorsByFlag(flagIOError)
+		assert.Equal(t, errIOError, filteredReport.FatalError().Unwrap())
+	})
+
+	t.Run("should filter while errors are added concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				report.AddFlaggedError(errReadError, nil, flagReadError)
+			}()
+			go func() {
+				defer wg.Done()
+				filteredReport := report.FilterErrorsByFlags(flagReadError, flagIOError)
+				assert.True(t, filteredReport.HasFatalError())
+				assert.GreaterOrEqual(t, filteredReport.Count(), 2)
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, concurrentWorkers+1, report.FilterErrorsByFlag(flagReadError).Count())
+	})
+}
+
+func TestConcurrentReport_Severity(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddWithSeverity(errReadError, nil, SeverityWarning)
+	report.AddFlaggedWithSeverity(errWriteError, nil, flagWriteError, SeverityFatal)
+
+	assert.True(t, report.HasFatalError())
+	assert.Equal(t, SeverityFatal, HighestSeverity(report))
+	assert.True(t, HasErrorsAtLeast(report, SeverityCritical))
+
+	filteredReport := report.Filter(BySeverityAtLeast(SeverityError))
+	assert.IsType(t, &ConcurrentReport{}, filteredReport)
+	assert.Equal(t, 1, filteredReport.Count())
+	assert.True(t, filteredReport.HasFatalError())
+}
+
+func TestConcurrentReport_MultiFlags(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagReadError)
+	report.AddMultiFlaggedFatalError(errWriteError, nil, flagWriteError, flagIOError)
+
+	filteredReport := report.Filter(ByFlagMatch(FlagMatchAll, flagIOError, flagWriteError))
+	assert.IsType(t, &ConcurrentReport{}, filteredReport)
+	assert.Equal(t, 1, filteredReport.Count())
+	assert.True(t, filteredReport.HasFatalError())
+	assert.Equal(t, 2, report.FilterErrorsByFlag(flagIOError).Count())
+}
+
+func TestConcurrentReport_AddReportError(t *testing.T) {
+	report := NewConcurrentReport()
+	AddReportError(report, ReportError{
+		WrappedError: errReadError,
+		Attributes:   Attributes{"retries": Int64Value(3)},
+		Severity:     SeverityFatal,
+	})
+
+	require.True(t, report.HasFatalError())
+	retries, ok := report.FatalError().Attributes.GetInt64("retries")
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), retries)
+}
+
+func TestConcurrentReport_ToErrorSlice(t *testing.T) {
+	report := NewConcurrentReport()
+
+	t.Run("should return an empty error slice when there are no errors", func(t *testing.T) {
+		assert.Equal(t, []error{}, report.ToErrorSlice())
+	})
+
+	t.Run("should return all errors as slice", func(t *testing.T) {
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+
+		assert.Equal(t, []error{errReadError, errWriteError}, report.ToErrorSlice())
+	})
+}
+
+func TestConcurrentReport_Unwrap(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddError(errReadError, nil)
+
+	assert.True(t, Contains(report, errReadError))
+	assert.True(t, errors.Is(report, errReadError))
+	assert.False(t, errors.Is(report, errWriteError))
+}
+
+func TestConcurrentReport_Error(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddError(errReadError, nil)
+	report.AddFatalError(errors.New("fatal"), nil)
+
+	assert.True(t, report.HasErrors())
+	assert.False(t, report.IsEmpty())
+	assert.Equal(t, "report contains 2 error(s)", report.Error())
+}
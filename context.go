@@ -0,0 +1,166 @@
+package yeterr
+
+import (
+	"context"
+	"sync"
+)
+
+// contextKey is the key of the report in a context.
+type contextKey struct{}
+
+// contextReport is the value stored in a context. It keeps the functions which run after a fatal error was added and
+// removes its hook from the report when no function is left.
+type contextReport struct {
+	report     Report
+	mu         sync.Mutex
+	removeHook func()
+	nextID     int
+	afterFatal map[int]func(ReportError)
+}
+
+// NewContext returns a derived context which carries the report. The report should be safe for concurrent use if the
+// context is shared by multiple goroutines.
+func NewContext(ctx context.Context, report Report) context.Context {
+	return context.WithValue(ctx, contextKey{}, &contextReport{
+		report:     report,
+		afterFatal: map[int]func(ReportError){},
+	})
+}
+
+// FromContext returns the report carried by the context. It returns false if the context does not carry a report.
+func FromContext(ctx context.Context) (Report, bool) {
+	entry, ok := fromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	return entry.report, true
+}
+
+// fromContext returns the value stored in the context.
+func fromContext(ctx context.Context) (*contextReport, bool) {
+	entry, ok := ctx.Value(contextKey{}).(*contextReport)
+	return entry, ok
+}
+
+// AddError adds an error item into the report carried by the context. It does nothing if the context does not carry
+// a report.
+func AddError(ctx context.Context, err error, metadata ErrorMetadata) {
+	addToContext(ctx, func(report Report) {
+		report.AddError(err, metadata)
+	})
+}
+
+// AddFlaggedError adds an error with a provided flag into the report carried by the context. It does nothing if the
+// context does not carry a report.
+func AddFlaggedError(ctx context.Context, err error, metadata ErrorMetadata, flag ErrorFlag) {
+	addToContext(ctx, func(report Report) {
+		report.AddFlaggedError(err, metadata, flag)
+	})
+}
+
+// AddFatalError adds a fatal error into the report carried by the context and runs the functions registered with
+// AfterFatal. It does nothing if the context does not carry a report.
+func AddFatalError(ctx context.Context, err error, metadata ErrorMetadata) {
+	addToContext(ctx, func(report Report) {
+		report.AddFatalError(err, metadata)
+	})
+}
+
+// AddFlaggedFatalError adds a fatal error with a provided flag into the report carried by the context and runs the
+// functions registered with AfterFatal. It does nothing if the context does not carry a report.
+func AddFlaggedFatalError(ctx context.Context, err error, metadata ErrorMetadata, flag ErrorFlag) {
+	addToContext(ctx, func(report Report) {
+		report.AddFlaggedFatalError(err, metadata, flag)
+	})
+}
+
+// addToContext calls add with the report carried by the context.
+func addToContext(ctx context.Context, add func(report Report)) {
+	if report, ok := FromContext(ctx); ok {
+		add(report)
+	}
+}
+
+// checkFatal runs all registered functions in their own goroutine once the report has a fatal error. Every function
+// runs only once.
+func (c *contextReport) checkFatal() {
+	fatalError := c.report.FatalError()
+	if fatalError == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, f := range c.afterFatal {
+		delete(c.afterFatal, id)
+		go f(*fatalError)
+	}
+
+	c.unhook()
+}
+
+// unhook removes the hook from the report if no function is registered anymore. It must be called while holding the
+// lock.
+func (c *contextReport) unhook() {
+	if len(c.afterFatal) > 0 || c.removeHook == nil {
+		return
+	}
+
+	c.removeHook()
+	c.removeHook = nil
+}
+
+// AfterFatal arranges to call f in its own goroutine after a fatal error was added to the report carried by the
+// context, either through the context or directly to the report. If the report already has a fatal error, f is
+// called immediately in its own goroutine. Calling the returned stop function stops the association of f with the context.
+// It returns true if it stopped f from being run. If the context does not carry a report, f is never called.
+func AfterFatal(ctx context.Context, f func(fatalError ReportError)) (stop func() bool) {
+	entry, ok := fromContext(ctx)
+	if !ok {
+		return func() bool { return false }
+	}
+
+	entry.mu.Lock()
+	id := entry.nextID
+	entry.nextID++
+	entry.afterFatal[id] = f
+
+	if entry.removeHook == nil {
+		entry.removeHook = entry.report.OnFatal(func(ReportError) {
+			entry.checkFatal()
+		})
+	}
+	entry.mu.Unlock()
+
+	entry.checkFatal()
+
+	return func() bool {
+		entry.mu.Lock()
+		defer entry.mu.Unlock()
+
+		_, registered := entry.afterFatal[id]
+		delete(entry.afterFatal, id)
+		entry.unhook()
+
+		return registered
+	}
+}
+
+// WithCancelOnFatal returns a derived context which carries the report and is canceled as soon as the report gets a
+// fatal error. The cause of the cancellation is the fatal error. Calling cancel releases the resources
+// of the context.
+func WithCancelOnFatal(ctx context.Context, report Report) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	ctx = NewContext(ctx, report)
+
+	stop := AfterFatal(ctx, func(fatalError ReportError) {
+		cancel(fatalError.WrappedError)
+	})
+
+	return ctx, func() {
+		stop()
+		cancel(context.Canceled)
+	}
+}
@@ -0,0 +1,187 @@
+package yeterr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Run("should return the report of the context", func(t *testing.T) {
+		report := NewSimpleReport()
+		ctx := NewContext(context.Background(), report)
+
+		contextReport, ok := FromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, report, contextReport)
+	})
+
+	t.Run("should return false without a report", func(t *testing.T) {
+		contextReport, ok := FromContext(context.Background())
+		assert.False(t, ok)
+		assert.Nil(t, contextReport)
+	})
+}
+
+func TestAddError_Context(t *testing.T) {
+	t.Run("should add errors to the report of the context", func(t *testing.T) {
+		report := NewSimpleReport()
+		ctx := NewContext(context.Background(), report)
+
+		AddError(ctx, errReadError, ErrorMetadata{"filename": "text.txt"})
+		AddFlaggedError(ctx, errWriteError, nil, flagWriteError)
+		AddFatalError(ctx, errIOError, nil)
+		AddFlaggedFatalError(ctx, errReadError, nil, flagReadError)
+
+		require.Equal(t, 4, report.Count())
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, report.FirstError().Metadata)
+		assert.Equal(t, flagWriteError, report.AllErrors()[1].Flag)
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+		assert.Equal(t, SeverityFatal, report.LastError().Severity)
+	})
+
+	t.Run("should do nothing without a report", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			AddError(context.Background(), errReadError, nil)
+			AddFatalError(context.Background(), errReadError, nil)
+		})
+	})
+}
+
+func TestAfterFatal(t *testing.T) {
+	t.Run("should call the function after a fatal error was added", func(t *testing.T) {
+		ctx := NewContext(context.Background(), NewConcurrentReport())
+		called := make(chan ReportError, 1)
+		AfterFatal(ctx, func(fatalError ReportError) {
+			called <- fatalError
+		})
+
+		AddError(ctx, errReadError, nil)
+		assert.Empty(t, called)
+
+		AddFatalError(ctx, errWriteError, nil)
+		select {
+		case fatalError := <-called:
+			assert.Equal(t, errWriteError, fatalError.Unwrap())
+		case <-time.After(time.Second):
+			t.Fatal("function was not called")
+		}
+	})
+
+	t.Run("should call the function immediately if the report already has a fatal error", func(t *testing.T) {
+		report := NewConcurrentReport()
+		report.AddFatalError(errWriteError, nil)
+		ctx := NewContext(context.Background(), report)
+
+		called := make(chan ReportError, 1)
+		stop := AfterFatal(ctx, func(fatalError ReportError) {
+			called <- fatalError
+		})
+
+		select {
+		case <-called:
+		case <-time.After(time.Second):
+			t.Fatal("function was not called")
+		}
+
+		assert.False(t, stop())
+	})
+
+	t.Run("should not call a stopped function", func(t *testing.T) {
+		ctx := NewContext(context.Background(), NewConcurrentReport())
+		called := make(chan ReportError, 1)
+		stop := AfterFatal(ctx, func(fatalError ReportError) {
+			called <- fatalError
+		})
+
+		assert.True(t, stop())
+		assert.False(t, stop())
+
+		entry, ok := fromContext(ctx)
+		require.True(t, ok)
+		assert.Empty(t, entry.afterFatal)
+
+		AddFatalError(ctx, errWriteError, nil)
+		assert.Empty(t, called)
+	})
+
+	t.Run("should remove its hook from the report when no function is left", func(t *testing.T) {
+		report := NewSimpleReport()
+		ctx := NewContext(context.Background(), report)
+
+		first := AfterFatal(ctx, func(ReportError) {})
+		second := AfterFatal(ctx, func(ReportError) {})
+		assert.Len(t, report.(*SimpleReport).onFatal, 1)
+
+		first()
+		assert.Len(t, report.(*SimpleReport).onFatal, 1)
+
+		second()
+		assert.Empty(t, report.(*SimpleReport).onFatal)
+	})
+
+	t.Run("should never call the function without a report", func(t *testing.T) {
+		stop := AfterFatal(context.Background(), func(fatalError ReportError) {
+			t.Error("function was called")
+		})
+
+		assert.False(t, stop())
+	})
+}
+
+func TestWithCancelOnFatal(t *testing.T) {
+	t.Run("should cancel the context with the fatal error as cause", func(t *testing.T) {
+		ctx, cancel := WithCancelOnFatal(context.Background(), NewConcurrentReport())
+		defer cancel()
+
+		AddError(ctx, errReadError, nil)
+		assert.NoError(t, ctx.Err())
+
+		AddFatalError(ctx, errWriteError, nil)
+		select {
+		case <-ctx.Done():
+			assert.Equal(t, errWriteError, context.Cause(ctx))
+		case <-time.After(time.Second):
+			t.Fatal("context was not canceled")
+		}
+	})
+
+	t.Run("should cancel the context on a fatal error added to the report", func(t *testing.T) {
+		report := NewSimpleReport()
+		ctx, cancel := WithCancelOnFatal(context.Background(), report)
+		defer cancel()
+
+		report.AddFatalError(errWriteError, nil)
+		select {
+		case <-ctx.Done():
+			assert.Equal(t, errWriteError, context.Cause(ctx))
+		case <-time.After(time.Second):
+			t.Fatal("context was not canceled")
+		}
+	})
+
+	t.Run("should cancel the context when cancel is called", func(t *testing.T) {
+		ctx, cancel := WithCancelOnFatal(context.Background(), NewConcurrentReport())
+		cancel()
+
+		assert.Equal(t, context.Canceled, ctx.Err())
+		assert.Equal(t, context.Canceled, context.Cause(ctx))
+
+		report, ok := FromContext(ctx)
+		require.True(t, ok)
+		assert.True(t, report.IsEmpty())
+	})
+
+	t.Run("should not leak hooks when contexts are canceled", func(t *testing.T) {
+		report := NewConcurrentReport()
+		for i := 0; i < 10; i++ {
+			_, cancel := WithCancelOnFatal(context.Background(), report)
+			cancel()
+		}
+
+		assert.Empty(t, report.(*ConcurrentReport).simple().onFatal)
+	})
+}
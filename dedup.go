@@ -0,0 +1,133 @@
+package yeterr
+
+import (
+	"strings"
+	"time"
+)
+
+// FingerprintFunc returns the fingerprint of an error item. A deduplicating report keeps only one item per
+// fingerprint.
+type FingerprintFunc func(ReportError) string
+
+// DefaultFingerprint returns a fingerprint function which considers the error message, all flags and the values of
+// the provided metadata keys or attributes.
+func DefaultFingerprint(metadataKeys ...string) FingerprintFunc {
+	return func(reportErr ReportError) string {
+		var builder strings.Builder
+		if reportErr.WrappedError != nil {
+			builder.WriteString(reportErr.Error())
+		}
+
+		for _, flag := range reportErr.AllFlags() {
+			builder.WriteString("\x00")
+			builder.WriteString(flag.String())
+		}
+
+		for _, key := range metadataKeys {
+			builder.WriteString("\x00")
+			builder.WriteString(key)
+			if value, ok := reportErr.Attribute(key); ok {
+				builder.WriteString("=")
+				builder.WriteString(value.String())
+			}
+		}
+
+		return builder.String()
+	}
+}
+
+// WithDeduplication makes the report keep only one error item per fingerprint. Duplicates increase the Occurrences
+// and update the LastSeen time of the kept item. A nil fingerprint function uses DefaultFingerprint without metadata
+// keys.
+func WithDeduplication(fingerprint FingerprintFunc) ReportOption {
+	return func(s *SimpleReport) {
+		if fingerprint == nil {
+			fingerprint = DefaultFingerprint()
+		}
+
+		s.fingerprint = fingerprint
+		s.fingerprints = nil
+	}
+}
+
+// UniqueCount returns the number of distinct items in the report. It only differs from Count for deduplicating
+// reports.
+func UniqueCount(report Report) int {
+	uniqueCount := 0
+	readReport(report, func(s *SimpleReport) {
+		uniqueCount = len(s.elements)
+	})
+
+	return uniqueCount
+}
+
+// occurrences returns how often the item was added. Items which were not deduplicated were added once.
+func (r ReportError) occurrences() int {
+	if r.Occurrences < 1 {
+		return 1
+	}
+
+	return r.Occurrences
+}
+
+// lastSeen returns the time of the latest occurrence of the item.
+func (r ReportError) lastSeen() time.Time {
+	if r.LastSeen.After(r.Time) {
+		return r.LastSeen
+	}
+
+	return r.Time
+}
+
+// addDuplicate merges the element into an existing item with the same fingerprint. It returns false and the
+// fingerprint of the element if there is no such item or the report does not deduplicate.
+func (s *SimpleReport) addDuplicate(element ReportError) (string, bool) {
+	if s.fingerprint == nil {
+		return "", false
+	}
+
+	if s.fingerprints == nil {
+		s.indexFingerprints()
+	}
+
+	fingerprint := s.fingerprint(element)
+	index, ok := s.fingerprints[fingerprint]
+	if !ok {
+		return fingerprint, false
+	}
+
+	existing := &s.elements[index]
+	s.addCount(element.occurrences())
+	existing.Occurrences = existing.occurrences() + element.occurrences()
+	s.countFlags(*existing, element.occurrences())
+	if element.lastSeen().After(existing.lastSeen()) {
+		existing.LastSeen = element.lastSeen()
+	}
+
+	if element.Severity > existing.Severity {
+		existing.Severity = element.Severity
+	}
+
+	if existing.Severity == SeverityFatal && !s.HasFatalError() {
+		existing.Fatal = true
+	}
+
+	if existing.Fatal {
+		fatalError := *existing
+		s.fatalError = &fatalError
+	}
+
+	return fingerprint, true
+}
+
+// indexFingerprints rebuilds the index of fingerprints from the items of the report.
+func (s *SimpleReport) indexFingerprints() {
+	s.fingerprints = make(map[string]int, len(s.elements))
+	for position := range s.elements {
+		index := s.index(position)
+		fingerprint := s.fingerprint(s.elements[index])
+		if _, ok := s.fingerprints[fingerprint]; !ok {
+			s.fingerprints[fingerprint] = index
+		}
+	}
+}
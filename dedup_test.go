@@ -0,0 +1,146 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultFingerprint(t *testing.T) {
+	t.Run("should consider message and flags", func(t *testing.T) {
+		fingerprint := DefaultFingerprint()
+
+		assert.Equal(t, fingerprint(elementRead), fingerprint(ReportError{WrappedError: errors.New(errReadError.Error()), Flag: flagReadError}))
+		assert.NotEqual(t, fingerprint(elementRead), fingerprint(ReportError{WrappedError: errReadError, Flag: flagIOError}))
+		assert.NotEqual(t, fingerprint(elementRead), fingerprint(ReportError{WrappedError: errReadError, Flag: flagReadError, Flags: []ErrorFlag{flagIOError}}))
+	})
+
+	t.Run("should consider only the selected metadata keys", func(t *testing.T) {
+		fingerprint := DefaultFingerprint("filename")
+
+		same := ReportError{WrappedError: errReadError, Flag: flagReadError, Metadata: ErrorMetadata{"filename": "text.txt", "row": "2"}}
+		other := ReportError{WrappedError: errReadError, Flag: flagReadError, Metadata: ErrorMetadata{"filename": "other.txt"}}
+
+		assert.Equal(t, fingerprint(elementRead), fingerprint(same))
+		assert.NotEqual(t, fingerprint(elementRead), fingerprint(other))
+	})
+}
+
+func TestWithDeduplication(t *testing.T) {
+	t.Run("should keep all items by default", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, 2, report.Count())
+		assert.Equal(t, 2, UniqueCount(report))
+		assert.Equal(t, 0, report.FirstError().Occurrences)
+	})
+
+	t.Run("should keep one item per fingerprint and count occurrences", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil), WithClock(steppingClock(referenceTime, time.Minute)))
+		for i := 0; i < 1000; i++ {
+			report.AddFlaggedError(errReadError, nil, flagReadError)
+		}
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+
+		assert.Equal(t, 1001, report.Count())
+		assert.Equal(t, 2, UniqueCount(report))
+
+		firstError := report.FirstError()
+		assert.Equal(t, 1000, firstError.Occurrences)
+		assert.Equal(t, referenceTime, firstError.Time)
+		assert.Equal(t, referenceTime.Add(999*time.Minute), firstError.LastSeen)
+		assert.Equal(t, 1, report.LastError().Occurrences)
+		assert.Equal(t, referenceTime.Add(1000*time.Minute), LastSeen(report))
+		assert.Equal(t, "report contains 1001 error(s)", report.Error())
+	})
+
+	t.Run("should use a custom fingerprint", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(func(reportErr ReportError) string {
+			return reportErr.Flag.String()
+		}))
+		report.AddFlaggedError(errReadError, nil, flagIOError)
+		report.AddFlaggedError(errWriteError, nil, flagIOError)
+
+		assert.Equal(t, 2, report.Count())
+		assert.Equal(t, 1, UniqueCount(report))
+		assert.Equal(t, errReadError, report.FirstError().Unwrap())
+	})
+
+	t.Run("should make a duplicate fatal when it is added as fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil))
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+		report.AddFlaggedFatalError(errIOError, nil, flagIOError)
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+
+		assert.Equal(t, 1, UniqueCount(report))
+		require.True(t, report.HasFatalError())
+		assert.True(t, report.FirstError().Fatal)
+		assert.Equal(t, SeverityFatal, report.FirstError().Severity)
+		assert.Equal(t, 3, report.FatalError().Occurrences)
+	})
+
+	t.Run("should keep deduplicating in filtered reports", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil))
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+
+		filteredReport := report.FilterErrorsByFlag(flagReadError)
+		filteredReport.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, 1, UniqueCount(filteredReport))
+		assert.Equal(t, 3, filteredReport.Count())
+		assert.Equal(t, 2, report.Count())
+	})
+
+	t.Run("should serialize occurrences and last seen", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil), WithClock(steppingClock(referenceTime, time.Second)))
+		report.AddError(errReadError, nil)
+		report.AddError(errReadError, nil)
+
+		data, err := json.Marshal(report.FirstError())
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"message": "this simulates a read error",
+			"flag": "none",
+			"severity": "error",
+			"time": "2020-04-01T12:00:00Z",
+			"last_seen": "2020-04-01T12:00:01Z",
+			"occurrences": 2
+		}`, string(data))
+	})
+
+	t.Run("should deduplicate into a decoded report", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil))
+		require.NoError(t, json.Unmarshal([]byte(`{"errors": [{"message": "this simulates a read error", "flag": "none", "occurrences": 2}]}`), report))
+
+		report.AddError(errReadError, nil)
+		assert.Equal(t, 1, UniqueCount(report))
+		assert.Equal(t, 3, report.Count())
+	})
+}
+
+func TestConcurrentReport_Deduplication(t *testing.T) {
+	report := NewConcurrentReport(WithDeduplication(nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrentWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.AddFlaggedError(errReadError, nil, flagReadError)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, concurrentWorkers, report.Count())
+	assert.Equal(t, 1, UniqueCount(report))
+}
@@ -0,0 +1,247 @@
+package yeterr
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// SummaryFunc returns the message of a report which is used by Error. It must not call Error of the report.
+type SummaryFunc func(report Report) string
+
+// WithSummary sets the function which creates the message returned by Error. A nil summary function uses
+// DefaultSummary.
+func WithSummary(summary SummaryFunc) ReportOption {
+	return func(s *SimpleReport) {
+		s.summary = summary
+	}
+}
+
+// DefaultSummary returns the number of errors and, for a report with limited capacity, the number of dropped errors,
+// e.g. "report contains 3 error(s)".
+func DefaultSummary(report Report) string {
+	if Dropped(report) > 0 {
+		return fmt.Sprintf("report contains %d error(s), %d error(s) dropped", report.Count(), Dropped(report))
+	}
+
+	return fmt.Sprintf("report contains %d error(s)", report.Count())
+}
+
+// FlagSummary returns the default summary with the number of errors per flag and the message of the fatal error,
+// e.g. "report contains 3 error(s) [read_error: 2, write_error: 1], fatal: disk full".
+func FlagSummary(report Report) string {
+	return countSummary(report, func(reportErr ReportError) []string {
+		flags := make([]string, 0, len(reportErr.Flags)+1)
+		for _, flag := range reportErr.AllFlags() {
+			flags = append(flags, flag.String())
+		}
+
+		return flags
+	})
+}
+
+// CatalogSummary returns a summary function which counts the errors per code of their flag definition in the catalog,
+// e.g. "report contains 3 error(s) [E_READ: 2, E_WRITE: 1], fatal: disk full". Errors with a flag without code are
+// counted by their flag.
+func CatalogSummary(catalog *Catalog) SummaryFunc {
+	return func(report Report) string {
+		return countSummary(report, func(reportErr ReportError) []string {
+			if definition, ok := catalog.Definition(reportErr); ok && definition.Code != "" {
+				return []string{definition.Code}
+			}
+
+			return []string{reportErr.Flag.String()}
+		})
+	}
+}
+
+// countSummary returns the default summary with the number of errors per key and the message of the fatal error.
+func countSummary(report Report, keys func(reportErr ReportError) []string) string {
+	counts := make(map[string]int)
+	for _, element := range report.AllErrors() {
+		for _, key := range keys(element) {
+			counts[key] += element.occurrences()
+		}
+	}
+
+	sortedKeys := make([]string, 0, len(counts))
+	for key := range counts {
+		sortedKeys = append(sortedKeys, key)
+	}
+
+	sort.Strings(sortedKeys)
+
+	var builder strings.Builder
+	builder.WriteString(DefaultSummary(report))
+
+	if len(sortedKeys) > 0 {
+		parts := make([]string, 0, len(sortedKeys))
+		for _, key := range sortedKeys {
+			parts = append(parts, fmt.Sprintf("%s: %d", key, counts[key]))
+		}
+
+		builder.WriteString(" [" + strings.Join(parts, ", ") + "]")
+	}
+
+	if report.HasFatalError() {
+		builder.WriteString(", fatal: " + report.FatalError().Message())
+	}
+
+	return builder.String()
+}
+
+// reportErrorDump has the fields of a ReportError without its methods, so it can be printed in Go syntax.
+type reportErrorDump ReportError
+
+// Format implements the fmt.Formatter interface. %s and %v print the error message, %q prints the quoted error
+// message. %+v prints the item with its flags, metadata and fatal marker followed by the captured stack or caller.
+// %#v prints the item in Go syntax. Other verbs are reported as bad verbs like fmt does.
+func (r ReportError) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			dump := fmt.Sprintf("%#v", reportErrorDump(r))
+			_, _ = io.WriteString(f, strings.Replace(dump, "yeterr.reportErrorDump", "yeterr.ReportError", 1))
+			return
+		}
+
+		if f.Flag('+') {
+			r.writeDetails(f)
+			r.writeFrames(f)
+			return
+		}
+
+		_, _ = io.WriteString(f, r.Message())
+	case 's':
+		_, _ = io.WriteString(f, r.Message())
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", r.Message())
+	default:
+		writeBadVerb(f, verb, r, r.Message())
+	}
+}
+
+// Message returns the message of the wrapped error, or "<nil>" like fmt does if there is no wrapped error. Unlike Error
+// it is safe to call for items without a wrapped error.
+func (r ReportError) Message() string {
+	if r.WrappedError == nil {
+		return "<nil>"
+	}
+
+	return r.Error()
+}
+
+// writeBadVerb writes the value with an unsupported verb the way fmt does, e.g. "%!d(yeterr.ReportError=message)".
+func writeBadVerb(w io.Writer, verb rune, value interface{}, message string) {
+	_, _ = fmt.Fprintf(w, "%%!%c(%T=%s)", verb, value, message)
+}
+
+// writeFrames writes the captured stack or, if there is no stack, the captured caller.
+func (r ReportError) writeFrames(w io.Writer) {
+	frames := r.Stack
+	if len(frames) == 0 && r.Caller != nil {
+		frames = []Frame{*r.Caller}
+	}
+
+	for _, frame := range frames {
+		_, _ = fmt.Fprintf(w, "\n\tat %s", frame)
+	}
+}
+
+// writeDetails writes the item as a single line with its flags, message, metadata and fatal marker, e.g.
+// "read_error: this simulates a read error [filename:text.txt] (fatal)".
+func (r ReportError) writeDetails(w io.Writer) {
+	flags := make([]string, 0, len(r.Flags)+1)
+	for _, flag := range r.AllFlags() {
+		flags = append(flags, flag.String())
+	}
+
+	_, _ = fmt.Fprintf(w, "%s: %s", strings.Join(flags, ","), r.Message())
+
+	if len(r.Metadata) > 0 || len(r.Attributes) > 0 {
+		attributes := r.Metadata.ToAttributes()
+		if attributes == nil {
+			attributes = make(Attributes, len(r.Attributes))
+		}
+
+		for key, value := range r.Attributes {
+			attributes[key] = value
+		}
+
+		_, _ = io.WriteString(w, " "+attributes.String())
+	}
+
+	if r.Fatal {
+		_, _ = io.WriteString(w, " (fatal)")
+	}
+}
+
+// Format implements the fmt.Formatter interface. %s and %v print the message of Error, %q prints the quoted message.
+// %+v additionally lists every item with its flags, metadata, fatal marker and captured stack or caller. %#v prints
+// the items and the fatal error of the report in Go syntax. Other verbs are reported as bad verbs like fmt does. A
+// report with a redactor is formatted redacted.
+func (s *SimpleReport) Format(f fmt.State, verb rune) {
+	s = s.exported()
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			s.writeGoSyntax(f)
+			return
+		}
+
+		_, _ = io.WriteString(f, s.Error())
+		if f.Flag('+') {
+			for _, element := range s.items() {
+				_, _ = io.WriteString(f, "\n- ")
+				element.writeDetails(f)
+				element.writeFrames(f)
+			}
+		}
+	case 's':
+		_, _ = io.WriteString(f, s.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", s.Error())
+	default:
+		writeBadVerb(f, verb, s, s.Error())
+	}
+}
+
+// writeGoSyntax writes the items and the fatal error of the report in Go syntax. The configuration of the report is
+// left out.
+func (s *SimpleReport) writeGoSyntax(w io.Writer) {
+	_, _ = io.WriteString(w, "&yeterr.SimpleReport{elements:[]yeterr.ReportError{")
+	for i, element := range s.items() {
+		if i > 0 {
+			_, _ = io.WriteString(w, ", ")
+		}
+
+		_, _ = fmt.Fprintf(w, "%#v", element)
+	}
+
+	_, _ = io.WriteString(w, "}")
+	if s.HasFatalError() {
+		_, _ = fmt.Fprintf(w, ", fatalError:&%#v", *s.fatalError)
+	}
+
+	_, _ = io.WriteString(w, "}")
+}
+
+// Format implements the fmt.Formatter interface. It formats the report like a SimpleReport.
+func (c *ConcurrentReport) Format(f fmt.State, verb rune) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	c.simple().Format(f, verb)
+}
+
+// Format implements the fmt.Formatter interface. It formats the items in the scope of the view like a SimpleReport.
+func (c *scopedReport) Format(f fmt.State, verb rune) {
+	view := c.view()
+	if formatter, ok := view.(fmt.Formatter); ok {
+		formatter.Format(f, verb)
+		return
+	}
+
+	_, _ = io.WriteString(f, view.Error())
+}
@@ -0,0 +1,184 @@
+package yeterr
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportError_Format(t *testing.T) {
+	reportErr := ReportError{
+		WrappedError: errReadError,
+		Flag:         flagReadError,
+		Caller: &Frame{
+			Function: "main.main",
+			File:     "/src/main.go",
+			Line:     12,
+		},
+	}
+
+	t.Run("should print the message for %s and %v", func(t *testing.T) {
+		assert.Equal(t, "this simulates a read error", fmt.Sprintf("%s", reportErr))
+		assert.Equal(t, "this simulates a read error", fmt.Sprintf("%v", reportErr))
+	})
+
+	t.Run("should print the quoted message for %q", func(t *testing.T) {
+		assert.Equal(t, `"this simulates a read error"`, fmt.Sprintf("%q", reportErr))
+	})
+
+	t.Run("should print the details and the caller for %+v", func(t *testing.T) {
+		expected := "read_error: this simulates a read error\n\tat main.main (/src/main.go:12)"
+		assert.Equal(t, expected, fmt.Sprintf("%+v", reportErr))
+	})
+
+	t.Run("should print flags, metadata and the fatal marker for %+v", func(t *testing.T) {
+		fatalErr := ReportError{
+			WrappedError: errWriteError,
+			Metadata:     ErrorMetadata{"filename": "text.txt"},
+			Flag:         flagWriteError,
+			Flags:        []ErrorFlag{flagIOError},
+			Fatal:        true,
+		}
+
+		expected := "write_error,io_error: this simulates a write error [filename:text.txt] (fatal)"
+		assert.Equal(t, expected, fmt.Sprintf("%+v", fatalErr))
+	})
+
+	t.Run("should report unsupported verbs like fmt does", func(t *testing.T) {
+		assert.Equal(t, "%!d(yeterr.ReportError=this simulates a read error)", fmt.Sprintf("%d", reportErr))
+		assert.Equal(t, "%!x(yeterr.ReportError=this simulates a read error)", fmt.Sprintf("%x", reportErr))
+	})
+
+	t.Run("should not panic without wrapped error", func(t *testing.T) {
+		assert.Equal(t, "<nil>", fmt.Sprintf("%v", ReportError{}))
+		assert.Equal(t, "none: <nil>", fmt.Sprintf("%+v", ReportError{Flag: ErrorFlagNone}))
+	})
+
+	t.Run("should prefer the stack over the caller for %+v", func(t *testing.T) {
+		stackErr := reportErr
+		stackErr.Stack = []Frame{
+			*reportErr.Caller,
+			{Function: "runtime.main", File: "/go/src/runtime/proc.go", Line: 250},
+		}
+
+		expected := "read_error: this simulates a read error\n\tat main.main (/src/main.go:12)\n" +
+			"\tat runtime.main (/go/src/runtime/proc.go:250)"
+		assert.Equal(t, expected, fmt.Sprintf("%+v", stackErr))
+	})
+}
+
+func TestReportError_Format_GoSyntax(t *testing.T) {
+	reportErr := ReportError{
+		WrappedError: errReadError,
+		Flag:         flagReadError,
+		Fatal:        true,
+	}
+
+	dump := fmt.Sprintf("%#v", reportErr)
+	assert.True(t, strings.HasPrefix(dump, "yeterr.ReportError{WrappedError:"))
+	assert.Contains(t, dump, `Flag:"read_error"`)
+	assert.Contains(t, dump, "Fatal:true")
+}
+
+func TestSimpleReport_Format(t *testing.T) {
+	report := NewSimpleReport()
+	report.AddFlaggedError(errReadError, ErrorMetadata{"filename": "text.txt"}, flagReadError)
+	AddReportError(report, ReportError{
+		WrappedError: errIOError,
+		Attributes:   Attributes{"retries": Int64Value(3)},
+		Flag:         flagIOError,
+		Flags:        []ErrorFlag{flagReadError},
+		Caller:       &Frame{Function: "main.main", File: "/src/main.go", Line: 12},
+	})
+	report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+	t.Run("should print the short message for %s, %v and %q", func(t *testing.T) {
+		assert.Equal(t, "report contains 3 error(s)", fmt.Sprintf("%s", report))
+		assert.Equal(t, "report contains 3 error(s)", fmt.Sprintf("%v", report))
+		assert.Equal(t, `"report contains 3 error(s)"`, fmt.Sprintf("%q", report))
+	})
+
+	t.Run("should list every item for %+v", func(t *testing.T) {
+		expected := "report contains 3 error(s)\n" +
+			"- read_error: this simulates a read error [filename:text.txt]\n" +
+			"- io_error,read_error: this simulates an IO error [retries:3]\n" +
+			"\tat main.main (/src/main.go:12)\n" +
+			"- write_error: this simulates a write error (fatal)"
+		assert.Equal(t, expected, fmt.Sprintf("%+v", report))
+	})
+
+	t.Run("should print the items and the fatal error in Go syntax for %#v", func(t *testing.T) {
+		dump := fmt.Sprintf("%#v", report)
+		assert.True(t, strings.HasPrefix(dump, "&yeterr.SimpleReport{elements:[]yeterr.ReportError{yeterr.ReportError{"))
+		assert.Contains(t, dump, `fatalError:&yeterr.ReportError{WrappedError:`)
+		assert.Contains(t, dump, `Flag:"write_error"`)
+		assert.NotContains(t, dump, "fingerprints")
+		assert.NotContains(t, dump, "redactor")
+	})
+
+	t.Run("should report unsupported verbs like fmt does", func(t *testing.T) {
+		assert.Equal(t, "%!d(*yeterr.SimpleReport=report contains 3 error(s))", fmt.Sprintf("%d", report))
+	})
+
+	t.Run("should list items without wrapped error for %+v", func(t *testing.T) {
+		nilReport := NewSimpleReport()
+		nilReport.AddError(nil, nil)
+
+		assert.Equal(t, "report contains 1 error(s)\n- none: <nil>", fmt.Sprintf("%+v", nilReport))
+	})
+
+	t.Run("should format concurrent reports and scoped reports like simple reports", func(t *testing.T) {
+		concurrentReport := NewConcurrentReport()
+		concurrentReport.Child("import").AddFlaggedError(errReadError, nil, flagReadError)
+
+		expected := "report contains 1 error(s)\n- read_error: this simulates a read error"
+		assert.Equal(t, expected, fmt.Sprintf("%+v", concurrentReport))
+		assert.Equal(t, expected, fmt.Sprintf("%+v", concurrentReport.Child("import")))
+	})
+}
+
+func TestWithSummary(t *testing.T) {
+	t.Run("should use the default summary", func(t *testing.T) {
+		report := NewSimpleReport(WithSummary(nil))
+		report.AddError(errReadError, nil)
+
+		assert.Equal(t, "report contains 1 error(s)", report.Error())
+	})
+
+	t.Run("should use the summary function", func(t *testing.T) {
+		report := NewSimpleReport(WithSummary(func(report Report) string {
+			return fmt.Sprintf("%d failures", report.Count())
+		}))
+		report.AddError(errReadError, nil)
+
+		assert.Equal(t, "1 failures", report.Error())
+		assert.Equal(t, "1 failures", report.FilterErrorsByFlag(ErrorFlagNone).Error())
+	})
+
+	t.Run("should summarize flags and the fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithSummary(FlagSummary), WithCapacity(3, OverflowReject))
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagReadError)
+		report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+		report.AddError(errReadError, nil)
+
+		expected := "report contains 3 error(s), 1 error(s) dropped [io_error: 1, read_error: 2, write_error: 1], " +
+			"fatal: this simulates a write error"
+		assert.Equal(t, expected, report.Error())
+	})
+
+	t.Run("should summarize an empty report", func(t *testing.T) {
+		assert.Equal(t, "report contains 0 error(s)", FlagSummary(NewSimpleReport()))
+	})
+
+	t.Run("should summarize the codes of the catalog", func(t *testing.T) {
+		report := NewSimpleReport(WithSummary(CatalogSummary(newTestCatalog(t))))
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddMultiFlaggedError(errIOError, nil, "unknown", flagReadError)
+		report.AddError(errWriteError, nil)
+
+		assert.Equal(t, "report contains 3 error(s) [E_READ: 2, none: 1]", report.Error())
+	})
+}
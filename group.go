@@ -0,0 +1,110 @@
+package yeterr
+
+import (
+	"slices"
+	"sort"
+)
+
+// Group is a sub-report of all error items which share the same key.
+type Group[K comparable] struct {
+	Key    K
+	Report Report
+}
+
+// GroupBy groups the error items of the report by the key returned for each item. The groups are ordered by the
+// first occurrence of their key. Each group is a full report and contains the fatal error if its key matches. The key
+// function must not call methods of the report.
+func GroupBy[K comparable](report Report, key func(ReportError) K) []Group[K] {
+	return groupItems(report, func(reportErr ReportError) []K {
+		return []K{key(reportErr)}
+	})
+}
+
+// groupItems groups the error items of the report in a single pass. An item is part of the group of each key
+// returned by keys. The keys are computed from the exported items, so they do not reveal redacted values, while the
+// groups keep the stored items. The groups are ordered by the first occurrence of their key.
+func groupItems[K comparable](report Report, keys func(ReportError) []K) []Group[K] {
+	var orderedKeys []K
+	groupReports := make(map[K]*SimpleReport)
+
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			elementKeys := keys(*s.exportedError(&element))
+			for i, groupKey := range elementKeys {
+				if slices.Contains(elementKeys[:i], groupKey) {
+					continue
+				}
+
+				groupReport, ok := groupReports[groupKey]
+				if !ok {
+					groupReport = s.newFilteredReport()
+					groupReports[groupKey] = groupReport
+					orderedKeys = append(orderedKeys, groupKey)
+				}
+
+				groupReport.elements = append(groupReport.elements, element)
+			}
+		}
+
+		if s.HasFatalError() {
+			for _, groupKey := range keys(*s.exportedError(s.fatalError)) {
+				if groupReport, ok := groupReports[groupKey]; ok {
+					groupReport.fatalError = s.fatalError
+				}
+			}
+		}
+	})
+
+	groups := make([]Group[K], 0, len(orderedKeys))
+	for _, groupKey := range orderedKeys {
+		groups = append(groups, Group[K]{
+			Key:    groupKey,
+			Report: wrapReport(report, groupReports[groupKey]),
+		})
+	}
+
+	return groups
+}
+
+// SortedKeys returns the keys of grouped reports in ascending order, so groups can be iterated deterministically.
+func SortedKeys[K ~string](groups map[K]Report) []K {
+	keys := make([]K, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// GroupByFlag groups the error items of the report by their flags. An item with additional flags is part of the group
+// of each of its flags. Each group contains the fatal error if it has the flag of the group.
+func GroupByFlag(report Report) map[ErrorFlag]Report {
+	return groupMap(groupItems(report, ReportError.AllFlags))
+}
+
+// GroupByMetadata groups the error items of the report by the value of a metadata key or attribute. Attributes are
+// grouped by their string representation. Items without the key are not part of any group.
+func GroupByMetadata(report Report, key string) map[string]Report {
+	return groupMap(groupItems(report, func(reportErr ReportError) []string {
+		value, ok := reportErr.Attribute(key)
+		if !ok {
+			return nil
+		}
+
+		return []string{value.String()}
+	}))
+}
+
+// groupMap returns the reports of the groups by their key.
+func groupMap[K comparable](groups []Group[K]) map[K]Report {
+	groupReports := make(map[K]Report, len(groups))
+	for _, group := range groups {
+		groupReports[group.Key] = group.Report
+	}
+
+	return groupReports
+}
@@ -0,0 +1,143 @@
+// Package group runs functions in goroutines and collects their errors into a report, similar to errgroup.
+package group
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pvormste/yeterr"
+)
+
+// Group runs functions in goroutines and records every returned error into a shared report. A Group must be created
+// with New or WithContext.
+type Group struct {
+	report yeterr.Report
+	limit  chan struct{}
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+}
+
+// Option configures a Group on creation.
+type Option func(*Group)
+
+// WithLimit limits the number of functions which run at the same time. A limit of zero or less means unlimited.
+func WithLimit(limit int) Option {
+	return func(g *Group) {
+		g.limit = nil
+		if limit > 0 {
+			g.limit = make(chan struct{}, limit)
+		}
+	}
+}
+
+// WithReport sets the report the errors are recorded into. The report must be safe for concurrent use. By default
+// the group records into a new ConcurrentReport.
+func WithReport(report yeterr.Report) Option {
+	return func(g *Group) {
+		g.report = report
+	}
+}
+
+// New creates a new group.
+func New(options ...Option) *Group {
+	group := &Group{
+		report: yeterr.NewConcurrentReport(),
+	}
+
+	for _, option := range options {
+		option(group)
+	}
+
+	return group
+}
+
+// WithContext creates a new group and a derived context. The derived context is canceled as soon as the report has a
+// fatal error or Wait returns. The cause of the cancellation is the fatal error.
+func WithContext(ctx context.Context, options ...Option) (*Group, context.Context) {
+	group := New(options...)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	group.cancel = cancel
+
+	return group, ctx
+}
+
+// Go runs the function in a new goroutine. A returned error is recorded with the metadata, the caller of Go becomes
+// the caller of the item. If the group has a limit, Go blocks until the function can run.
+func (g *Group) Go(fn func() error, metadata yeterr.ErrorMetadata) {
+	g.run(fn, metadata, yeterr.SeverityError)
+}
+
+// GoFatal runs the function in a new goroutine. A returned error is recorded as fatal error with the metadata, so it
+// cancels the context of a group created by WithContext. The caller of GoFatal becomes the caller of the item.
+func (g *Group) GoFatal(fn func() error, metadata yeterr.ErrorMetadata) {
+	g.run(fn, metadata, yeterr.SeverityFatal)
+}
+
+// run runs the function in a new goroutine and records a returned error with the severity. The call site is captured
+// according to the capture mode of the report before the goroutine starts, its own stack only consists of the group
+// and the runtime.
+func (g *Group) run(fn func() error, metadata yeterr.ErrorMetadata, severity yeterr.Severity) {
+	caller, stack := yeterr.CaptureFrames(g.report, 2)
+
+	if g.limit != nil {
+		g.limit <- struct{}{}
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+
+		if err := fn(); err != nil {
+			yeterr.AddReportError(g.report, yeterr.ReportError{
+				WrappedError: err,
+				Metadata:     metadata,
+				Flag:         yeterr.ErrorFlagNone,
+				Severity:     severity,
+				Caller:       caller,
+				Stack:        stack,
+			})
+			g.cancelOnFatal()
+		}
+	}()
+}
+
+// done marks a function as finished and frees its slot.
+func (g *Group) done() {
+	if g.limit != nil {
+		<-g.limit
+	}
+
+	g.wg.Done()
+}
+
+// cancelOnFatal cancels the context of the group if the report has a fatal error.
+func (g *Group) cancelOnFatal() {
+	if g.cancel == nil {
+		return
+	}
+
+	if fatalError := g.report.FatalError(); fatalError != nil {
+		g.cancel(fatalError.WrappedError)
+	}
+}
+
+// Report returns the report of the group. It can be inspected while functions are still running.
+func (g *Group) Report() yeterr.Report {
+	return g.report
+}
+
+// Wait blocks until all functions returned. It returns the report or nil if no function returned an error.
+func (g *Group) Wait() yeterr.Report {
+	g.wg.Wait()
+
+	if g.cancel != nil {
+		g.cancel(context.Canceled)
+	}
+
+	if g.report.IsEmpty() {
+		return nil
+	}
+
+	return g.report
+}
@@ -0,0 +1,144 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+)
+
+var (
+	errReadError  = errors.New("this simulates a read error")
+	errWriteError = errors.New("this simulates a write error")
+)
+
+func TestGroup_Wait(t *testing.T) {
+	t.Run("should return nil without errors", func(t *testing.T) {
+		group := New()
+		for i := 0; i < 10; i++ {
+			group.Go(func() error { return nil }, nil)
+		}
+
+		assert.Nil(t, group.Wait())
+		assert.True(t, group.Report().IsEmpty())
+	})
+
+	t.Run("should collect every error with its metadata", func(t *testing.T) {
+		group := New()
+		for i := 0; i < 10; i++ {
+			i := i
+			group.Go(func() error {
+				if i%2 == 0 {
+					return errReadError
+				}
+
+				return nil
+			}, yeterr.ErrorMetadata{"worker": strconv.Itoa(i)})
+		}
+
+		report := group.Wait()
+		require.NotNil(t, report)
+		assert.Equal(t, 5, report.Count())
+		assert.Len(t, yeterr.GroupByMetadata(report, "worker"), 5)
+		assert.Equal(t, 1, report.Filter(yeterr.ByMetadata("worker", "4")).Count())
+	})
+
+	t.Run("should record into the provided report", func(t *testing.T) {
+		report := yeterr.NewConcurrentReport()
+		group := New(WithReport(report))
+		group.GoFatal(func() error { return errWriteError }, nil)
+
+		assert.Equal(t, report, group.Wait())
+		assert.True(t, report.HasFatalError())
+	})
+
+	t.Run("should use the caller of Go as caller of the item", func(t *testing.T) {
+		group := New(WithReport(yeterr.NewConcurrentReport(yeterr.WithCapture(yeterr.CaptureCaller))))
+		group.Go(func() error { return errReadError }, nil)
+		group.GoFatal(func() error { return errWriteError }, nil)
+
+		report := group.Wait()
+		require.NotNil(t, report)
+		for _, element := range report.AllErrors() {
+			require.NotNil(t, element.Caller)
+			assert.True(t, strings.HasSuffix(element.Caller.File, "group_test.go"))
+			assert.Contains(t, element.Caller.Function, "TestGroup_Wait")
+		}
+	})
+
+	t.Run("should not capture a caller without capture mode", func(t *testing.T) {
+		group := New()
+		group.Go(func() error { return errReadError }, nil)
+
+		report := group.Wait()
+		require.NotNil(t, report)
+		assert.Nil(t, report.FirstError().Caller)
+	})
+}
+
+func TestWithLimit(t *testing.T) {
+	var running, maxRunning int32
+
+	group := New(WithLimit(2))
+	for i := 0; i < 10; i++ {
+		group.Go(func() error {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		}, nil)
+	}
+
+	assert.Nil(t, group.Wait())
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestWithContext(t *testing.T) {
+	t.Run("should cancel the context on a fatal error", func(t *testing.T) {
+		group, ctx := WithContext(context.Background())
+
+		group.Go(func() error { return errReadError }, nil)
+		group.GoFatal(func() error { return errWriteError }, nil)
+		group.Go(func() error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, yeterr.ErrorMetadata{"worker": "waiting"})
+
+		report := group.Wait()
+		require.NotNil(t, report)
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+		assert.True(t, yeterr.Contains(report, context.Canceled))
+		assert.Equal(t, errWriteError, context.Cause(ctx))
+	})
+
+	t.Run("should not cancel the context with other errors as cause", func(t *testing.T) {
+		group, ctx := WithContext(context.Background())
+		group.Go(func() error { return errReadError }, nil)
+
+		require.NotNil(t, group.Wait())
+		assert.Equal(t, context.Canceled, context.Cause(ctx))
+	})
+
+	t.Run("should cancel the context when Wait returns", func(t *testing.T) {
+		group, ctx := WithContext(context.Background())
+		group.Go(func() error { return nil }, nil)
+
+		assert.Nil(t, group.Wait())
+		assert.Error(t, ctx.Err())
+	})
+}
@@ -0,0 +1,128 @@
+package yeterr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGroupReport creates a report with items of different flags and filenames and a fatal write error.
+func newGroupReport(options ...ReportOption) Report {
+	report := NewSimpleReport(options...)
+	report.AddFlaggedError(errReadError, ErrorMetadata{"filename": "a.txt"}, flagReadError)
+	report.AddFlaggedFatalError(errWriteError, ErrorMetadata{"filename": "b.txt"}, flagWriteError)
+	report.AddMultiFlaggedError(errIOError, ErrorMetadata{"filename": "a.txt"}, flagIOError, flagReadError)
+	AddReportError(report, ReportError{
+		WrappedError: errIOError,
+		Attributes:   Attributes{"line": IntValue(3)},
+		Flag:         flagIOError,
+	})
+
+	return report
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Run("should return no groups for an empty report", func(t *testing.T) {
+		groups := GroupBy(NewSimpleReport(), func(reportErr ReportError) ErrorFlag {
+			return reportErr.Flag
+		})
+
+		assert.Empty(t, groups)
+	})
+
+	t.Run("should group by key ordered by first occurrence", func(t *testing.T) {
+		groups := GroupBy(newGroupReport(), func(reportErr ReportError) Severity {
+			return reportErr.Severity
+		})
+
+		require.Len(t, groups, 2)
+		assert.Equal(t, SeverityError, groups[0].Key)
+		assert.Equal(t, 3, groups[0].Report.Count())
+		assert.False(t, groups[0].Report.HasFatalError())
+
+		assert.Equal(t, SeverityFatal, groups[1].Key)
+		assert.Equal(t, 1, groups[1].Report.Count())
+		assert.True(t, groups[1].Report.HasFatalError())
+	})
+
+	t.Run("should return the same order on every call", func(t *testing.T) {
+		report := newGroupReport()
+		keyFunc := func(reportErr ReportError) string {
+			return reportErr.Error()
+		}
+
+		first := GroupBy(report, keyFunc)
+		for i := 0; i < 10; i++ {
+			groups := GroupBy(report, keyFunc)
+			for j := range groups {
+				assert.Equal(t, first[j].Key, groups[j].Key)
+			}
+		}
+	})
+}
+
+func TestSimpleReport_GroupByFlag(t *testing.T) {
+	t.Run("should return no groups for an empty report", func(t *testing.T) {
+		assert.Empty(t, GroupByFlag(NewSimpleReport()))
+	})
+
+	t.Run("should group by all flags and keep the fatal error in its group", func(t *testing.T) {
+		groups := GroupByFlag(newGroupReport())
+
+		assert.Equal(t, []ErrorFlag{flagIOError, flagReadError, flagWriteError}, SortedKeys(groups))
+		assert.Equal(t, 2, groups[flagReadError].Count())
+		assert.Equal(t, 2, groups[flagIOError].Count())
+		assert.Equal(t, 1, groups[flagWriteError].Count())
+
+		assert.False(t, groups[flagReadError].HasFatalError())
+		assert.False(t, groups[flagIOError].HasFatalError())
+		require.True(t, groups[flagWriteError].HasFatalError())
+		assert.Equal(t, errWriteError, groups[flagWriteError].FatalError().Unwrap())
+	})
+
+	t.Run("should add an item with a repeated flag only once to its group", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagReadError, flagReadError)
+
+		groups := GroupByFlag(report)
+
+		assert.Equal(t, 1, groups[flagReadError].Count())
+	})
+}
+
+func TestSimpleReport_GroupByMetadata(t *testing.T) {
+	t.Run("should group by metadata and attributes and skip items without the key", func(t *testing.T) {
+		report := newGroupReport()
+
+		groups := GroupByMetadata(report, "filename")
+		assert.Equal(t, []string{"a.txt", "b.txt"}, SortedKeys(groups))
+		assert.Equal(t, 2, groups["a.txt"].Count())
+		assert.True(t, groups["b.txt"].HasFatalError())
+
+		lineGroups := GroupByMetadata(report, "line")
+		assert.Equal(t, []string{"3"}, SortedKeys(lineGroups))
+		assert.Equal(t, 1, lineGroups["3"].Count())
+	})
+}
+
+func TestConcurrentReport_GroupBy(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddFlaggedError(errReadError, ErrorMetadata{"filename": "a.txt"}, flagReadError)
+	report.AddFlaggedFatalError(errWriteError, ErrorMetadata{"filename": "b.txt"}, flagWriteError)
+
+	flagGroups := GroupByFlag(report)
+	require.Len(t, flagGroups, 2)
+	assert.IsType(t, &ConcurrentReport{}, flagGroups[flagReadError])
+	assert.True(t, flagGroups[flagWriteError].HasFatalError())
+
+	metadataGroups := GroupByMetadata(report, "filename")
+	require.Len(t, metadataGroups, 2)
+	assert.IsType(t, &ConcurrentReport{}, metadataGroups["a.txt"])
+
+	groups := GroupBy(report, func(reportErr ReportError) ErrorFlag {
+		return reportErr.Flag
+	})
+	require.Len(t, groups, 2)
+	assert.IsType(t, &ConcurrentReport{}, groups[0].Report)
+}
@@ -0,0 +1,142 @@
+package yeterr
+
+// OnAdd registers a hook which is called for every error added to the report, including duplicates of a
+// deduplicating report and errors which were dropped because the report was full. The hook gets the added item with
+// its time and fatal marker set.
+//
+// Hooks are called synchronously by the goroutine which added the error, after the item was stored. They are called
+// in the order of their registration and OnAdd hooks are called before OnFatal hooks. A panicking hook is recovered,
+// the panic does not reach the caller and the remaining hooks are still called. Hooks are not carried over to
+// filtered reports. Calling the returned remove function removes the hook from the report.
+func (s *SimpleReport) OnAdd(hook func(ReportError)) (remove func()) {
+	registered := &registeredHook{call: hook}
+	s.onAdd = append(s.onAdd, registered)
+
+	return func() {
+		s.onAdd = removeHook(s.onAdd, registered)
+	}
+}
+
+// OnFatal registers a hook which is called once when the report gets its fatal error. The hook gets the fatal error.
+// The same guarantees as for OnAdd apply.
+func (s *SimpleReport) OnFatal(hook func(ReportError)) (remove func()) {
+	registered := &registeredHook{call: hook}
+	s.onFatal = append(s.onFatal, registered)
+
+	return func() {
+		s.onFatal = removeHook(s.onFatal, registered)
+	}
+}
+
+// OnAdd registers a hook which is called for every error added to the report. The hooks are called after the lock of
+// the report was released, so they can use the report. Hooks of errors added by different goroutines may run
+// concurrently. Otherwise the same guarantees as for SimpleReport apply.
+func (c *ConcurrentReport) OnAdd(hook func(ReportError)) (remove func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lockedRemove(c.simple().OnAdd(hook))
+}
+
+// OnFatal registers a hook which is called once when the report gets its fatal error. The hooks are called after the
+// lock of the report was released.
+func (c *ConcurrentReport) OnFatal(hook func(ReportError)) (remove func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lockedRemove(c.simple().OnFatal(hook))
+}
+
+// lockedRemove returns a function which calls remove while holding the write lock.
+func (c *ConcurrentReport) lockedRemove(remove func()) func() {
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		remove()
+	}
+}
+
+// OnAdd registers a hook at the parent which is called for every error added to the parent in the scope of the view.
+func (c *scopedReport) OnAdd(hook func(ReportError)) (remove func()) {
+	return c.parent.OnAdd(scopedHook(c.scope, hook))
+}
+
+// OnFatal registers a hook at the parent which is called when the parent gets its fatal error in the scope of the
+// view.
+func (c *scopedReport) OnFatal(hook func(ReportError)) (remove func()) {
+	return c.parent.OnFatal(scopedHook(c.scope, hook))
+}
+
+// scopedHook returns a hook which only calls the hook for items in the scope.
+func scopedHook(scope string, hook func(ReportError)) func(ReportError) {
+	inScope := ByScope(scope)
+	return func(reportErr ReportError) {
+		if inScope(reportErr) {
+			hook(reportErr)
+		}
+	}
+}
+
+// runHooks calls the hooks for the added element and, if the report got its fatal error, the fatal error. The hooks
+// of a report which defers hooks are kept as pending until they are run by the ConcurrentReport.
+func (s *SimpleReport) runHooks(element ReportError, becameFatal bool) {
+	if len(s.onAdd) == 0 && (!becameFatal || len(s.onFatal) == 0) {
+		return
+	}
+
+	onAdd := s.onAdd
+	element = *s.exportedError(&element)
+	var onFatal []*registeredHook
+	var fatalError ReportError
+	if becameFatal {
+		onFatal = s.onFatal
+		fatalError = *s.FatalError()
+	}
+
+	run := func() {
+		callHooks(onAdd, element)
+		callHooks(onFatal, fatalError)
+	}
+
+	if s.deferHooks {
+		s.pendingHooks = append(s.pendingHooks, run)
+		return
+	}
+
+	run()
+}
+
+// registeredHook is a hook registered at a report. The pointer identifies the hook when it is removed.
+type registeredHook struct {
+	call func(ReportError)
+}
+
+// removeHook returns the hooks without the removed hook. The hooks are copied, so hooks which are about to run are
+// not changed.
+func removeHook(hooks []*registeredHook, removed *registeredHook) []*registeredHook {
+	remaining := make([]*registeredHook, 0, len(hooks))
+	for _, hook := range hooks {
+		if hook != removed {
+			remaining = append(remaining, hook)
+		}
+	}
+
+	return remaining
+}
+
+// callHooks calls every hook with the item.
+func callHooks(hooks []*registeredHook, reportErr ReportError) {
+	for _, hook := range hooks {
+		callHook(hook.call, reportErr)
+	}
+}
+
+// callHook calls the hook and recovers from a panic of the hook.
+func callHook(hook func(ReportError), reportErr ReportError) {
+	defer func() {
+		_ = recover()
+	}()
+
+	hook(reportErr)
+}
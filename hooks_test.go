@@ -0,0 +1,231 @@
+package yeterr
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHook returns a hook which records the messages of all items it was called with.
+func recordingHook(messages *[]string) func(ReportError) {
+	return func(reportErr ReportError) {
+		*messages = append(*messages, reportErr.Error())
+	}
+}
+
+func TestSimpleReport_OnAdd(t *testing.T) {
+	t.Run("should call the hooks for every added error in registration order", func(t *testing.T) {
+		var calls []string
+		report := NewSimpleReport()
+		report.OnAdd(func(reportErr ReportError) {
+			calls = append(calls, "first: "+reportErr.Error())
+		})
+		report.OnAdd(func(reportErr ReportError) {
+			calls = append(calls, "second: "+reportErr.Error())
+		})
+
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+		assert.Equal(t, []string{
+			"first: " + errReadError.Error(),
+			"second: " + errReadError.Error(),
+			"first: " + errWriteError.Error(),
+			"second: " + errWriteError.Error(),
+		}, calls)
+	})
+
+	t.Run("should call the hooks with the stored item", func(t *testing.T) {
+		var added []ReportError
+		report := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+		report.OnAdd(func(reportErr ReportError) {
+			added = append(added, reportErr)
+		})
+
+		report.AddFatalError(errWriteError, nil)
+		report.AddFatalError(errReadError, nil)
+
+		require.Len(t, added, 2)
+		assert.Equal(t, referenceTime, added[0].Time)
+		assert.True(t, added[0].Fatal)
+		assert.False(t, added[1].Fatal)
+	})
+
+	t.Run("should call the hooks for duplicates and dropped errors", func(t *testing.T) {
+		var messages []string
+		report := NewSimpleReport(WithDeduplication(nil), WithCapacity(1, OverflowReject))
+		report.OnAdd(recordingHook(&messages))
+
+		report.AddError(errReadError, nil)
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+
+		assert.Equal(t, []string{errReadError.Error(), errReadError.Error(), errWriteError.Error()}, messages)
+	})
+
+	t.Run("should isolate panicking hooks", func(t *testing.T) {
+		var messages []string
+		report := NewSimpleReport()
+		report.OnAdd(func(ReportError) {
+			panic("hook failed")
+		})
+		report.OnAdd(recordingHook(&messages))
+
+		assert.NotPanics(t, func() {
+			report.AddError(errReadError, nil)
+		})
+		assert.Equal(t, []string{errReadError.Error()}, messages)
+		assert.Equal(t, 1, report.Count())
+	})
+
+	t.Run("should not carry hooks over to filtered reports", func(t *testing.T) {
+		var messages []string
+		report := NewSimpleReport()
+		report.OnAdd(recordingHook(&messages))
+
+		filteredReport := report.FilterErrorsByFlag(ErrorFlagNone)
+		filteredReport.AddError(errReadError, nil)
+		assert.Empty(t, messages)
+
+		filteredReport.OnAdd(recordingHook(&messages))
+		filteredReport.AddError(errWriteError, nil)
+		assert.Equal(t, []string{errWriteError.Error()}, messages)
+	})
+
+	t.Run("should not call removed hooks", func(t *testing.T) {
+		var first, second []string
+		report := NewSimpleReport()
+		remove := report.OnAdd(recordingHook(&first))
+		report.OnAdd(recordingHook(&second))
+
+		report.AddError(errReadError, nil)
+		remove()
+		remove()
+		report.AddError(errWriteError, nil)
+
+		assert.Equal(t, []string{errReadError.Error()}, first)
+		assert.Equal(t, []string{errReadError.Error(), errWriteError.Error()}, second)
+	})
+}
+
+func TestSimpleReport_OnFatal(t *testing.T) {
+	t.Run("should call the hooks once for the fatal error after the add hooks", func(t *testing.T) {
+		var calls []string
+		report := NewSimpleReport()
+		report.OnFatal(func(reportErr ReportError) {
+			calls = append(calls, "fatal: "+reportErr.Error())
+		})
+		report.OnAdd(func(reportErr ReportError) {
+			calls = append(calls, "add: "+reportErr.Error())
+		})
+
+		report.AddError(errReadError, nil)
+		report.AddFatalError(errWriteError, nil)
+		report.AddFatalError(errIOError, nil)
+
+		assert.Equal(t, []string{
+			"add: " + errReadError.Error(),
+			"add: " + errWriteError.Error(),
+			"fatal: " + errWriteError.Error(),
+			"add: " + errIOError.Error(),
+		}, calls)
+	})
+
+	t.Run("should call the hooks for merged fatal errors", func(t *testing.T) {
+		var messages []string
+		other := NewSimpleReport()
+		other.AddFatalError(errWriteError, nil)
+
+		report := NewSimpleReport()
+		report.OnFatal(recordingHook(&messages))
+		report.Merge(other)
+
+		assert.Equal(t, []string{errWriteError.Error()}, messages)
+	})
+}
+
+func TestConcurrentReport_Hooks(t *testing.T) {
+	t.Run("should call the hooks after releasing the lock", func(t *testing.T) {
+		report := NewConcurrentReport()
+		counts := make(chan int, 1)
+		report.OnFatal(func(reportErr ReportError) {
+			counts <- report.Count()
+		})
+
+		report.AddError(errReadError, nil)
+		report.AddFatalError(errWriteError, nil)
+
+		assert.Equal(t, 2, <-counts)
+	})
+
+	t.Run("should call the hooks for every concurrently added error", func(t *testing.T) {
+		var mu sync.Mutex
+		added := 0
+		fatal := 0
+
+		report := NewConcurrentReport()
+		report.OnAdd(func(ReportError) {
+			mu.Lock()
+			defer mu.Unlock()
+			added++
+		})
+		report.OnFatal(func(ReportError) {
+			mu.Lock()
+			defer mu.Unlock()
+			fatal++
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < concurrentWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				report.AddFatalError(errIOError, nil)
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, concurrentWorkers, added)
+		assert.Equal(t, 1, fatal)
+	})
+
+	t.Run("should work for filtered concurrent reports", func(t *testing.T) {
+		var messages []string
+		filteredReport := NewConcurrentReport().FilterErrorsByFlag(ErrorFlagNone)
+		filteredReport.OnAdd(recordingHook(&messages))
+		filteredReport.AddError(errReadError, nil)
+
+		assert.Equal(t, []string{errReadError.Error()}, messages)
+	})
+
+	t.Run("should not call removed hooks", func(t *testing.T) {
+		var messages []string
+		report := NewConcurrentReport()
+		remove := report.Child("import").OnFatal(recordingHook(&messages))
+		remove()
+
+		report.Child("import").AddFatalError(errWriteError, nil)
+
+		assert.Empty(t, messages)
+		assert.Empty(t, report.(*ConcurrentReport).simple().onFatal)
+	})
+}
+
+func TestScopedReport_Hooks(t *testing.T) {
+	var added, fatal []string
+	report := NewSimpleReport()
+	importReport := report.Child("import")
+	importReport.OnAdd(recordingHook(&added))
+	importReport.OnFatal(recordingHook(&fatal))
+
+	report.AddError(errIOError, nil)
+	importReport.Child("users").AddError(errReadError, nil)
+	importReport.AddFatalError(errWriteError, nil)
+	report.WithMetadata(ErrorMetadata{"job_id": "42"}).OnAdd(recordingHook(&added))
+	report.AddError(errIOError, nil)
+
+	assert.Equal(t, []string{errReadError.Error(), errWriteError.Error(), errIOError.Error()}, added)
+	assert.Equal(t, []string{errWriteError.Error()}, fatal)
+}
@@ -0,0 +1,256 @@
+// Package http renders reports as RFC 9457 problem details (application/problem+json) and parses such responses back
+// into reports.
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+
+	"github.com/pvormste/yeterr"
+)
+
+// ContentType is the media type of problem details.
+const ContentType = "application/problem+json"
+
+// Problem is a problem details object according to RFC 9457. Errors is an extension member with one entry per error
+// item of the report.
+type Problem struct {
+	Type     string         `json:"type,omitempty"`
+	Title    string         `json:"title,omitempty"`
+	Status   int            `json:"status,omitempty"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError is an entry of the errors extension member of a problem.
+type ProblemError struct {
+	Detail     string               `json:"detail"`
+	MessageKey string               `json:"message_key,omitempty"`
+	Flag       yeterr.ErrorFlag     `json:"flag"`
+	Flags      []yeterr.ErrorFlag   `json:"flags,omitempty"`
+	Severity   yeterr.Severity      `json:"severity"`
+	Scope      string               `json:"scope,omitempty"`
+	Metadata   yeterr.ErrorMetadata `json:"metadata,omitempty"`
+	Fatal      bool                 `json:"fatal,omitempty"`
+	Code       string               `json:"code,omitempty"`
+	Retryable  bool                 `json:"retryable,omitempty"`
+}
+
+// Report converts the problem into a report. Every entry of the errors extension member becomes an error item with
+// its detail as message. The fatal entry becomes the fatal error of the report.
+func (p Problem) Report() yeterr.Report {
+	report := yeterr.NewSimpleReport()
+	for _, problemErr := range p.Errors {
+		severity := problemErr.Severity
+		if problemErr.Fatal {
+			severity = yeterr.SeverityFatal
+		}
+
+		yeterr.AddReportError(report, yeterr.ReportError{
+			WrappedError: errors.New(problemErr.Detail),
+			MessageKey:   problemErr.MessageKey,
+			Metadata:     problemErr.Metadata,
+			Flag:         problemErr.Flag,
+			Flags:        problemErr.Flags,
+			Severity:     severity,
+			Scope:        problemErr.Scope,
+		})
+	}
+
+	return report
+}
+
+// Renderer renders reports as problem details. The status code is derived from the flags of the error items.
+type Renderer struct {
+	statuses      map[yeterr.ErrorFlag]int
+	defaultStatus int
+	problemType   string
+	title         string
+	catalog       *yeterr.Catalog
+}
+
+// Option configures a Renderer on creation.
+type Option func(*Renderer)
+
+// WithStatus maps the flag to the HTTP status code.
+func WithStatus(flag yeterr.ErrorFlag, status int) Option {
+	return func(r *Renderer) {
+		r.statuses[flag] = status
+	}
+}
+
+// WithStatuses maps all flags of the mapping to their HTTP status codes.
+func WithStatuses(statuses map[yeterr.ErrorFlag]int) Option {
+	return func(r *Renderer) {
+		for flag, status := range statuses {
+			r.statuses[flag] = status
+		}
+	}
+}
+
+// WithDefaultStatus sets the status code for reports without any mapped flag. The default is 400 Bad Request.
+func WithDefaultStatus(status int) Option {
+	return func(r *Renderer) {
+		r.defaultStatus = status
+	}
+}
+
+// WithType sets the type URI of rendered problems. Without a type, problems are of the type "about:blank".
+func WithType(problemType string) Option {
+	return func(r *Renderer) {
+		r.problemType = problemType
+	}
+}
+
+// WithTitle sets the title of rendered problems. The default is the status text of the status code.
+func WithTitle(title string) Option {
+	return func(r *Renderer) {
+		r.title = title
+	}
+}
+
+// WithCatalog enriches rendered problems from the catalog. Entries get the code and retryable bit of the definition of
+// their flags, and flags without a mapped status code use the HTTP status of their definition.
+func WithCatalog(catalog *yeterr.Catalog) Option {
+	return func(r *Renderer) {
+		r.catalog = catalog
+	}
+}
+
+// NewRenderer creates a renderer for problem details.
+func NewRenderer(options ...Option) *Renderer {
+	renderer := &Renderer{
+		statuses:      map[yeterr.ErrorFlag]int{},
+		defaultStatus: nethttp.StatusBadRequest,
+	}
+
+	for _, option := range options {
+		option(renderer)
+	}
+
+	return renderer
+}
+
+// Status returns the HTTP status code for the report. The fatal error takes precedence: if one of its flags is
+// mapped, its status code is used. Otherwise the status code of the first error item with a mapped flag is used and
+// the default status code if there is none.
+func (r *Renderer) Status(report yeterr.Report) int {
+	if report.HasFatalError() {
+		if status, ok := r.mappedStatus(*report.FatalError()); ok {
+			return status
+		}
+	}
+
+	for _, element := range report.AllErrors() {
+		if status, ok := r.mappedStatus(element); ok {
+			return status
+		}
+	}
+
+	return r.defaultStatus
+}
+
+// mappedStatus returns the status code of the first mapped flag of the error item. Without a mapped flag, the HTTP
+// status of the definition in the catalog is used.
+func (r *Renderer) mappedStatus(reportErr yeterr.ReportError) (int, bool) {
+	for _, flag := range reportErr.AllFlags() {
+		if status, ok := r.statuses[flag]; ok {
+			return status, true
+		}
+	}
+
+	if definition, ok := r.definition(reportErr); ok && definition.HTTPStatus != 0 {
+		return definition.HTTPStatus, true
+	}
+
+	return 0, false
+}
+
+// definition returns the definition of the error item in the catalog of the renderer.
+func (r *Renderer) definition(reportErr yeterr.ReportError) (yeterr.FlagDefinition, bool) {
+	if r.catalog == nil {
+		return yeterr.FlagDefinition{}, false
+	}
+
+	return r.catalog.Definition(reportErr)
+}
+
+// Problem returns the report as problem details. The detail is the message of the report. A report with a redactor
+// is rendered redacted.
+func (r *Renderer) Problem(report yeterr.Report) Problem {
+	report = yeterr.Redacted(report)
+	status := r.Status(report)
+	problem := Problem{
+		Type:   r.problemType,
+		Title:  r.title,
+		Status: status,
+		Detail: report.Error(),
+	}
+
+	if problem.Title == "" {
+		problem.Title = nethttp.StatusText(status)
+	}
+
+	for _, element := range report.AllErrors() {
+		problemErr := ProblemError{
+			Detail:     element.Message(),
+			MessageKey: element.MessageKey,
+			Flag:       element.Flag,
+			Flags:      element.Flags,
+			Severity:   element.Severity,
+			Scope:      element.Scope,
+			Metadata:   element.Metadata,
+			Fatal:      element.Fatal,
+		}
+
+		if definition, ok := r.definition(element); ok {
+			problemErr.Code = definition.Code
+			problemErr.Retryable = definition.Retryable
+		}
+
+		problem.Errors = append(problem.Errors, problemErr)
+	}
+
+	return problem
+}
+
+// Write writes the report as problem details response with the derived status code.
+func (r *Renderer) Write(w nethttp.ResponseWriter, report yeterr.Report) error {
+	problem := r.Problem(report)
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(problem.Status)
+
+	return json.NewEncoder(w).Encode(problem)
+}
+
+// ParseProblem decodes problem details.
+func ParseProblem(body io.Reader) (Problem, error) {
+	var problem Problem
+	if err := json.NewDecoder(body).Decode(&problem); err != nil {
+		return Problem{}, err
+	}
+
+	return problem, nil
+}
+
+// ParseResponse decodes the problem details of the response into a report. It returns an error if the response is
+// not a problem details response. The body of the response is not closed.
+func ParseResponse(response *nethttp.Response) (yeterr.Report, error) {
+	mediaType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil || mediaType != ContentType {
+		return nil, fmt.Errorf("yeterr/http: unexpected content type %q", response.Header.Get("Content-Type"))
+	}
+
+	problem, err := ParseProblem(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return problem.Report(), nil
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+)
+
+const (
+	flagInvalid  yeterr.ErrorFlag = "invalid"
+	flagNotFound yeterr.ErrorFlag = "not_found"
+	flagDatabase yeterr.ErrorFlag = "database"
+)
+
+var (
+	errInvalidEmail = errors.New("email is invalid")
+	errUnknownUser  = errors.New("user does not exist")
+	errConnection   = errors.New("connection refused")
+)
+
+func newRenderer() *Renderer {
+	return NewRenderer(
+		WithStatus(flagInvalid, nethttp.StatusUnprocessableEntity),
+		WithStatuses(map[yeterr.ErrorFlag]int{
+			flagNotFound: nethttp.StatusNotFound,
+			flagDatabase: nethttp.StatusServiceUnavailable,
+		}),
+	)
+}
+
+func TestRenderer_Status(t *testing.T) {
+	t.Run("should return the default status without mapped flags", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddError(errInvalidEmail, nil)
+
+		assert.Equal(t, nethttp.StatusBadRequest, newRenderer().Status(report))
+		assert.Equal(t, nethttp.StatusConflict, NewRenderer(WithDefaultStatus(nethttp.StatusConflict)).Status(report))
+	})
+
+	t.Run("should return the status of the first mapped flag", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddError(errInvalidEmail, nil)
+		report.AddMultiFlaggedError(errUnknownUser, nil, yeterr.ErrorFlagNone, flagNotFound)
+		report.AddFlaggedError(errInvalidEmail, nil, flagInvalid)
+
+		assert.Equal(t, nethttp.StatusNotFound, newRenderer().Status(report))
+	})
+
+	t.Run("should prefer the status of the fatal error", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errInvalidEmail, nil, flagInvalid)
+		report.AddFlaggedFatalError(errConnection, nil, flagDatabase)
+
+		assert.Equal(t, nethttp.StatusServiceUnavailable, newRenderer().Status(report))
+	})
+
+	t.Run("should ignore a fatal error without mapped flag", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errInvalidEmail, nil, flagInvalid)
+		report.AddFatalError(errConnection, nil)
+
+		assert.Equal(t, nethttp.StatusUnprocessableEntity, newRenderer().Status(report))
+	})
+}
+
+func TestRenderer_Write(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	report.AddFlaggedError(errInvalidEmail, yeterr.ErrorMetadata{"field": "email"}, flagInvalid)
+	report.Child("address").AddWithSeverity(errors.New("zip code is missing"), nil, yeterr.SeverityWarning)
+
+	recorder := httptest.NewRecorder()
+	renderer := NewRenderer(WithStatus(flagInvalid, nethttp.StatusUnprocessableEntity), WithType("https://example.com/problems/validation"))
+	require.NoError(t, renderer.Write(recorder, report))
+
+	assert.Equal(t, nethttp.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, ContentType, recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/validation",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"detail": "report contains 2 error(s)",
+		"errors": [
+			{"detail": "email is invalid", "flag": "invalid", "severity": "error", "metadata": {"field": "email"}},
+			{"detail": "zip code is missing", "flag": "none", "severity": "warning", "scope": "address"}
+		]
+	}`, recorder.Body.String())
+}
+
+func TestRenderer_Problem(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	report.AddFatalError(errConnection, nil)
+
+	problem := NewRenderer(WithTitle("Request failed")).Problem(report)
+	assert.Equal(t, "Request failed", problem.Title)
+	assert.Equal(t, nethttp.StatusBadRequest, problem.Status)
+	require.Len(t, problem.Errors, 1)
+	assert.True(t, problem.Errors[0].Fatal)
+}
+
+func TestRenderer_Problem_NilError(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	report.AddError(nil, nil)
+
+	problem := NewRenderer().Problem(report)
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, "<nil>", problem.Errors[0].Detail)
+}
+
+func TestWithCatalog(t *testing.T) {
+	catalog, err := yeterr.NewCatalog(
+		yeterr.FlagDefinition{Flag: flagDatabase, Code: "DB_UNAVAILABLE", HTTPStatus: 502, Retryable: true},
+		yeterr.FlagDefinition{Flag: flagNotFound, Code: "USER_NOT_FOUND", HTTPStatus: 404},
+	)
+	require.NoError(t, err)
+
+	t.Run("should use the status of the catalog for unmapped flags", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errConnection, nil, flagDatabase)
+
+		assert.Equal(t, nethttp.StatusBadGateway, NewRenderer(WithCatalog(catalog)).Status(report))
+	})
+
+	t.Run("should prefer mapped statuses over the catalog", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errConnection, nil, flagDatabase)
+
+		renderer := NewRenderer(WithCatalog(catalog), WithStatus(flagDatabase, nethttp.StatusServiceUnavailable))
+		assert.Equal(t, nethttp.StatusServiceUnavailable, renderer.Status(report))
+	})
+
+	t.Run("should add codes and retryable bits to the entries", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errConnection, nil, flagDatabase)
+		report.AddFlaggedError(errUnknownUser, nil, flagNotFound)
+		report.AddFlaggedError(errInvalidEmail, nil, flagInvalid)
+
+		problem := NewRenderer(WithCatalog(catalog)).Problem(report)
+		require.Len(t, problem.Errors, 3)
+		assert.Equal(t, "DB_UNAVAILABLE", problem.Errors[0].Code)
+		assert.True(t, problem.Errors[0].Retryable)
+		assert.Equal(t, "USER_NOT_FOUND", problem.Errors[1].Code)
+		assert.False(t, problem.Errors[1].Retryable)
+		assert.Empty(t, problem.Errors[2].Code)
+	})
+}
+
+func TestRenderer_Problem_Redacted(t *testing.T) {
+	report := yeterr.NewSimpleReport(yeterr.WithRedactor(yeterr.NewRedactor(yeterr.RedactKeys("email"))))
+	report.AddFlaggedError(errInvalidEmail, yeterr.ErrorMetadata{"email": "alice@example"}, flagInvalid)
+
+	problem := newRenderer().Problem(report)
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, yeterr.ErrorMetadata{"email": yeterr.RedactedValue}, problem.Errors[0].Metadata)
+	assert.Equal(t, yeterr.RedactedValue, report.FirstError().Metadata["email"])
+}
+
+func TestParseResponse(t *testing.T) {
+	t.Run("should parse a rendered report", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		report.AddFlaggedError(errInvalidEmail, yeterr.ErrorMetadata{"field": "email"}, flagInvalid)
+		report.AddMultiFlaggedFatalError(errConnection, nil, flagDatabase, flagNotFound)
+		report.Child("address").AddWithSeverity(errors.New("zip code is missing"), nil, yeterr.SeverityWarning)
+
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			_ = newRenderer().Write(w, report)
+		}))
+		defer server.Close()
+
+		response, err := nethttp.Get(server.URL)
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		parsedReport, err := ParseResponse(response)
+		require.NoError(t, err)
+
+		require.Equal(t, 3, parsedReport.Count())
+		assert.Equal(t, "email is invalid", parsedReport.FirstError().Error())
+		assert.Equal(t, flagInvalid, parsedReport.FirstError().Flag)
+		assert.Equal(t, yeterr.ErrorMetadata{"field": "email"}, parsedReport.FirstError().Metadata)
+		require.True(t, parsedReport.HasFatalError())
+		assert.Equal(t, "connection refused", parsedReport.FatalError().Error())
+		assert.Equal(t, []yeterr.ErrorFlag{flagNotFound}, parsedReport.FatalError().Flags)
+		assert.Equal(t, "address", parsedReport.LastError().Scope)
+		assert.Equal(t, yeterr.SeverityWarning, parsedReport.LastError().Severity)
+	})
+
+	t.Run("should return an error for other content types", func(t *testing.T) {
+		response := &nethttp.Response{
+			Header: nethttp.Header{"Content-Type": []string{"text/plain"}},
+			Body:   nethttp.NoBody,
+		}
+
+		_, err := ParseResponse(response)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return an error for invalid problem details", func(t *testing.T) {
+		_, err := ParseProblem(strings.NewReader(`{"errors": {}}`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,200 @@
+// Package i18n localizes the messages of report entries. Entries with a message key are rendered per locale with
+// text/template message catalogs, the metadata and attributes of an entry are the parameters of its template.
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/pvormste/yeterr"
+)
+
+// Localizer renders the message of a report entry for a locale.
+type Localizer interface {
+	Localize(locale string, reportErr yeterr.ReportError) string
+}
+
+// Bundle is a Localizer with message catalogs per locale. Locales are matched exactly first and by their base
+// language afterwards, e.g. "de-AT" falls back to "de". It is safe for concurrent use.
+type Bundle struct {
+	mu             sync.RWMutex
+	catalogs       map[string]map[string]*template.Template
+	fallbackLocale string
+}
+
+// Option configures a Bundle on creation.
+type Option func(*Bundle)
+
+// WithFallbackLocale sets the locale which is used when the requested locale does not have a message for the key.
+func WithFallbackLocale(locale string) Option {
+	return func(b *Bundle) {
+		b.fallbackLocale = normalizeLocale(locale)
+	}
+}
+
+// NewBundle creates a bundle without messages.
+func NewBundle(options ...Option) *Bundle {
+	bundle := &Bundle{
+		catalogs: map[string]map[string]*template.Template{},
+	}
+
+	for _, option := range options {
+		option(bundle)
+	}
+
+	return bundle
+}
+
+// AddMessages adds the message templates keyed by message key to the catalog of the locale. Templates use the
+// text/template syntax, e.g. "{{.path}} must have at least {{.min}} characters". It returns an error if a template can
+// not be parsed, no message is added then.
+func (b *Bundle) AddMessages(locale string, messages map[string]string) error {
+	templates := make(map[string]*template.Template, len(messages))
+	for key, message := range messages {
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(message)
+		if err != nil {
+			return fmt.Errorf("yeterr/i18n: invalid message %q for locale %q: %w", key, locale, err)
+		}
+
+		templates[key] = tmpl
+	}
+
+	locale = normalizeLocale(locale)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.catalogs[locale] == nil {
+		b.catalogs[locale] = map[string]*template.Template{}
+	}
+
+	for key, tmpl := range templates {
+		b.catalogs[locale][key] = tmpl
+	}
+
+	return nil
+}
+
+// LoadFS adds the message catalogs of all files in the file system matching the patterns, e.g. an embed.FS or
+// os.DirFS. A file is a JSON object with message templates keyed by message key, its name without extension is the
+// locale, e.g. "de-AT.json".
+func (b *Bundle) LoadFS(fsys fs.FS, patterns ...string) error {
+	for _, pattern := range patterns {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if err := b.loadFile(fsys, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadFile adds the message catalog of the file.
+func (b *Bundle) loadFile(fsys fs.FS, name string) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	var messages map[string]string
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return fmt.Errorf("yeterr/i18n: invalid message catalog %q: %w", name, err)
+	}
+
+	base := path.Base(name)
+	return b.AddMessages(strings.TrimSuffix(base, path.Ext(base)), messages)
+}
+
+// Localize implements the Localizer interface. It renders the template of the message key of the entry for the
+// locale. It falls back to the message of the wrapped error if the entry does not have a message key, no locale has
+// a message for the key or the template fails, e.g. because of a missing parameter. The metadata of the entry is
+// rendered as it is: entries of a report with a redactor are already redacted, other entries can be redacted with
+// Redactor.RedactError before.
+func (b *Bundle) Localize(locale string, reportErr yeterr.ReportError) string {
+	if reportErr.MessageKey != "" {
+		if tmpl, ok := b.lookup(locale, reportErr.MessageKey); ok {
+			var buffer bytes.Buffer
+			if err := tmpl.Execute(&buffer, parameters(reportErr)); err == nil {
+				return buffer.String()
+			}
+		}
+	}
+
+	if reportErr.WrappedError == nil {
+		return ""
+	}
+
+	return reportErr.Error()
+}
+
+// lookup returns the template of the key for the first matching locale.
+func (b *Bundle) lookup(locale string, key string) (*template.Template, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, candidate := range b.candidates(locale) {
+		if tmpl, ok := b.catalogs[candidate][key]; ok {
+			return tmpl, true
+		}
+	}
+
+	return nil, false
+}
+
+// candidates returns the locales which are searched for a message, ordered by precedence.
+func (b *Bundle) candidates(locale string) []string {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, base)
+	}
+
+	if b.fallbackLocale != "" {
+		candidates = append(candidates, b.fallbackLocale)
+	}
+
+	return candidates
+}
+
+// Messages returns the localized messages of all entries of the report. The entries of a report with a redactor are
+// localized redacted.
+func Messages(localizer Localizer, locale string, report yeterr.Report) []string {
+	messages := make([]string, 0, report.Count())
+	for _, element := range report.AllErrors() {
+		messages = append(messages, localizer.Localize(locale, element))
+	}
+
+	return messages
+}
+
+// parameters returns the template parameters of the entry. Metadata takes precedence over attributes with the same
+// key.
+func parameters(reportErr yeterr.ReportError) map[string]interface{} {
+	params := make(map[string]interface{}, len(reportErr.Attributes)+len(reportErr.Metadata))
+	for key, value := range reportErr.Attributes {
+		params[key] = value.Any()
+	}
+
+	for key, value := range reportErr.Metadata {
+		params[key] = value
+	}
+
+	return params
+}
+
+// normalizeLocale returns the locale in lower case with dashes, e.g. "de_AT" becomes "de-at".
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
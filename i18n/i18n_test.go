@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+	"github.com/pvormste/yeterr/validation"
+)
+
+var errUnknownUser = errors.New("user does not exist")
+
+func newBundle(t *testing.T) *Bundle {
+	bundle := NewBundle(WithFallbackLocale("en"))
+	err := bundle.LoadFS(fstest.MapFS{
+		"locales/en.json": {Data: []byte(`{
+			"user.unknown": "User {{.user}} does not exist",
+			"validation.min_length": "{{.path}} must have at least {{.min}} characters"
+		}`)},
+		"locales/de.json": {Data: []byte(`{
+			"user.unknown": "Benutzer {{.user}} existiert nicht"
+		}`)},
+		"locales/de_AT.json": {Data: []byte(`{
+			"user.unknown": "Den Benutzer {{.user}} gibt es nicht"
+		}`)},
+	}, "locales/*.json")
+	require.NoError(t, err)
+
+	return bundle
+}
+
+func unknownUser(metadata yeterr.ErrorMetadata) yeterr.ReportError {
+	return yeterr.ReportError{
+		WrappedError: errUnknownUser,
+		Metadata:     metadata,
+		MessageKey:   "user.unknown",
+	}
+}
+
+func TestBundle_Localize(t *testing.T) {
+	bundle := newBundle(t)
+
+	t.Run("should render the message of the locale", func(t *testing.T) {
+		reportErr := unknownUser(yeterr.ErrorMetadata{"user": "alice"})
+
+		assert.Equal(t, "User alice does not exist", bundle.Localize("en", reportErr))
+		assert.Equal(t, "Benutzer alice existiert nicht", bundle.Localize("de", reportErr))
+		assert.Equal(t, "Den Benutzer alice gibt es nicht", bundle.Localize("de-AT", reportErr))
+	})
+
+	t.Run("should fall back to the base language and the fallback locale", func(t *testing.T) {
+		reportErr := unknownUser(yeterr.ErrorMetadata{"user": "alice"})
+
+		assert.Equal(t, "Benutzer alice existiert nicht", bundle.Localize("de_CH", reportErr))
+		assert.Equal(t, "User alice does not exist", bundle.Localize("fr", reportErr))
+	})
+
+	t.Run("should fall back to the error message", func(t *testing.T) {
+		assert.Equal(t, "user does not exist", bundle.Localize("en", yeterr.ReportError{WrappedError: errUnknownUser}))
+		assert.Equal(t, "user does not exist", bundle.Localize("en", unknownUser(nil)))
+		assert.Equal(t, "user does not exist", NewBundle().Localize("en", unknownUser(nil)))
+	})
+
+	t.Run("should use attributes as parameters", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		validation.Root(report).Field("name").MinLength("al", 3)
+
+		assert.Equal(t, "name must have at least 3 characters", bundle.Localize("de", *report.FirstError()))
+	})
+}
+
+func TestBundle_AddMessages(t *testing.T) {
+	bundle := NewBundle()
+	require.NoError(t, bundle.AddMessages("en", map[string]string{"user.unknown": "{{.user}} is unknown"}))
+	assert.Error(t, bundle.AddMessages("en", map[string]string{"user.unknown": "{{.user"}))
+
+	assert.Equal(t, "alice is unknown", bundle.Localize("EN", unknownUser(yeterr.ErrorMetadata{"user": "alice"})))
+}
+
+func TestBundle_LoadFS(t *testing.T) {
+	err := NewBundle().LoadFS(fstest.MapFS{"en.json": {Data: []byte(`[]`)}}, "*.json")
+	assert.Error(t, err)
+}
+
+func TestMessages(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	yeterr.AddReportError(report, unknownUser(yeterr.ErrorMetadata{"user": "alice"}))
+	report.AddError(errors.New("connection refused"), nil)
+
+	expected := []string{"Benutzer alice existiert nicht", "connection refused"}
+	assert.Equal(t, expected, Messages(newBundle(t), "de", report))
+}
@@ -0,0 +1,179 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// reportJSON is the JSON representation of a report.
+type reportJSON struct {
+	Errors     []ReportError `json:"errors"`
+	FatalError *ReportError  `json:"fatal_error,omitempty"`
+	Dropped    int           `json:"dropped,omitempty"`
+}
+
+// reportErrorJSON is the JSON representation of a report error.
+type reportErrorJSON struct {
+	Message     string        `json:"message"`
+	MessageKey  string        `json:"message_key,omitempty"`
+	Chain       []string      `json:"chain,omitempty"`
+	Flag        ErrorFlag     `json:"flag"`
+	Flags       []ErrorFlag   `json:"flags,omitempty"`
+	Severity    Severity      `json:"severity"`
+	Scope       string        `json:"scope,omitempty"`
+	Metadata    ErrorMetadata `json:"metadata,omitempty"`
+	Attributes  Attributes    `json:"attributes,omitempty"`
+	Time        *time.Time    `json:"time,omitempty"`
+	LastSeen    *time.Time    `json:"last_seen,omitempty"`
+	Occurrences int           `json:"occurrences,omitempty"`
+	Fatal       bool          `json:"fatal,omitempty"`
+	Caller      *Frame        `json:"caller,omitempty"`
+	Stack       []Frame       `json:"stack,omitempty"`
+}
+
+// decodedError is the error type used for wrapped errors which were restored from JSON. It keeps the original
+// message and the messages of the unwrap chain.
+type decodedError struct {
+	message string
+	wrapped error
+}
+
+// Error implements the error interface.
+func (d *decodedError) Error() string {
+	return d.message
+}
+
+// Unwrap returns the next error of the restored unwrap chain.
+func (d *decodedError) Unwrap() error {
+	return d.wrapped
+}
+
+// MarshalJSON implements the json.Marshaler interface. The wrapped error is represented by its message and the
+// messages of its unwrap chain.
+func (r ReportError) MarshalJSON() ([]byte, error) {
+	reportErrJSON := reportErrorJSON{
+		MessageKey:  r.MessageKey,
+		Flag:        r.Flag,
+		Flags:       r.Flags,
+		Severity:    r.Severity,
+		Scope:       r.Scope,
+		Metadata:    r.Metadata,
+		Attributes:  r.Attributes,
+		Fatal:       r.Fatal,
+		Caller:      r.Caller,
+		Stack:       r.Stack,
+		Occurrences: r.Occurrences,
+	}
+
+	if !r.Time.IsZero() {
+		reportErrJSON.Time = &r.Time
+	}
+
+	if !r.LastSeen.IsZero() {
+		reportErrJSON.LastSeen = &r.LastSeen
+	}
+
+	if r.WrappedError != nil {
+		reportErrJSON.Message = r.WrappedError.Error()
+		for err := errors.Unwrap(r.WrappedError); err != nil; err = errors.Unwrap(err) {
+			reportErrJSON.Chain = append(reportErrJSON.Chain, err.Error())
+		}
+	}
+
+	return json.Marshal(reportErrJSON)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The wrapped error is restored as an error chain with the
+// original messages, the original error types are not restored.
+func (r *ReportError) UnmarshalJSON(data []byte) error {
+	var reportErrJSON reportErrorJSON
+	if err := json.Unmarshal(data, &reportErrJSON); err != nil {
+		return err
+	}
+
+	var wrappedError error
+	for i := len(reportErrJSON.Chain) - 1; i >= 0; i-- {
+		wrappedError = &decodedError{
+			message: reportErrJSON.Chain[i],
+			wrapped: wrappedError,
+		}
+	}
+
+	*r = ReportError{
+		WrappedError: &decodedError{
+			message: reportErrJSON.Message,
+			wrapped: wrappedError,
+		},
+		Metadata:    reportErrJSON.Metadata,
+		Attributes:  reportErrJSON.Attributes,
+		MessageKey:  reportErrJSON.MessageKey,
+		Flag:        reportErrJSON.Flag,
+		Flags:       reportErrJSON.Flags,
+		Severity:    reportErrJSON.Severity,
+		Scope:       reportErrJSON.Scope,
+		Fatal:       reportErrJSON.Fatal,
+		Caller:      reportErrJSON.Caller,
+		Stack:       reportErrJSON.Stack,
+		Occurrences: reportErrJSON.Occurrences,
+	}
+
+	if reportErrJSON.Time != nil {
+		r.Time = *reportErrJSON.Time
+	}
+
+	if reportErrJSON.LastSeen != nil {
+		r.LastSeen = *reportErrJSON.LastSeen
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. A report with a redactor is marshaled redacted.
+func (s *SimpleReport) MarshalJSON() ([]byte, error) {
+	s = s.exported()
+	return json.Marshal(reportJSON{
+		Errors:     s.items(),
+		FatalError: s.fatalError,
+		Dropped:    s.dropped,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the content of the report with the decoded
+// error items and fatal error.
+func (s *SimpleReport) UnmarshalJSON(data []byte) error {
+	var decodedReport reportJSON
+	if err := json.Unmarshal(data, &decodedReport); err != nil {
+		return err
+	}
+
+	s.elements = decodedReport.Errors
+	if s.elements == nil {
+		s.elements = []ReportError{}
+	}
+
+	s.head = 0
+	s.fatalError = decodedReport.FatalError
+	s.dropped = decodedReport.Dropped
+	s.fingerprints = nil
+	s.flagCounts = nil
+	s.counted = false
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c *ConcurrentReport) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the content of the report with the decoded
+// error items and fatal error.
+func (c *ConcurrentReport) UnmarshalJSON(data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.simple().UnmarshalJSON(data)
+}
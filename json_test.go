@@ -0,0 +1,186 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportError_MarshalJSON(t *testing.T) {
+	t.Run("should marshal message, flag and metadata", func(t *testing.T) {
+		data, err := json.Marshal(elementRead)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"message": "this simulates a read error",
+			"flag": "read_error",
+			"severity": "error",
+			"metadata": {"filename": "text.txt"}
+		}`, string(data))
+	})
+
+	t.Run("should marshal the unwrap chain and fatal marker", func(t *testing.T) {
+		reportErr := ReportError{
+			WrappedError: fmt.Errorf("read config: %w", fmt.Errorf("open file: %w", io.EOF)),
+			Flag:         flagReadError,
+			Severity:     SeverityFatal,
+			Fatal:        true,
+		}
+
+		data, err := json.Marshal(reportErr)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"message": "read config: open file: EOF",
+			"chain": ["open file: EOF", "EOF"],
+			"flag": "read_error",
+			"severity": "fatal",
+			"fatal": true
+		}`, string(data))
+	})
+}
+
+func TestReportError_UnmarshalJSON(t *testing.T) {
+	t.Run("should return an error for invalid json", func(t *testing.T) {
+		var reportErr ReportError
+		assert.Error(t, json.Unmarshal([]byte(`{"message": 1}`), &reportErr))
+		assert.Error(t, json.Unmarshal([]byte(`{"message": "m", "severity": "unknown"}`), &reportErr))
+	})
+
+	t.Run("should default to SeverityError when severity is missing", func(t *testing.T) {
+		var reportErr ReportError
+		require.NoError(t, json.Unmarshal([]byte(`{"message": "m"}`), &reportErr))
+		assert.Equal(t, SeverityError, reportErr.Severity)
+	})
+
+	t.Run("should restore the message key", func(t *testing.T) {
+		data, err := json.Marshal(ReportError{WrappedError: errReadError, MessageKey: "file.unreadable"})
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"message_key":"file.unreadable"`)
+
+		var reportErr ReportError
+		require.NoError(t, json.Unmarshal(data, &reportErr))
+		assert.Equal(t, "file.unreadable", reportErr.MessageKey)
+	})
+
+	t.Run("should restore the error chain with the original messages", func(t *testing.T) {
+		var reportErr ReportError
+		err := json.Unmarshal([]byte(`{
+			"message": "read config: open file: EOF",
+			"chain": ["open file: EOF", "EOF"],
+			"flag": "read_error",
+			"severity": "critical",
+			"metadata": {"filename": "text.txt"},
+			"fatal": true
+		}`), &reportErr)
+		require.NoError(t, err)
+
+		assert.Equal(t, "read config: open file: EOF", reportErr.Error())
+		assert.Equal(t, flagReadError, reportErr.Flag)
+		assert.Equal(t, SeverityCritical, reportErr.Severity)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, reportErr.Metadata)
+		assert.True(t, reportErr.Fatal)
+
+		unwrapped := errors.Unwrap(reportErr.WrappedError)
+		require.NotNil(t, unwrapped)
+		assert.Equal(t, "open file: EOF", unwrapped.Error())
+
+		unwrapped = errors.Unwrap(unwrapped)
+		require.NotNil(t, unwrapped)
+		assert.Equal(t, "EOF", unwrapped.Error())
+		assert.Nil(t, errors.Unwrap(unwrapped))
+	})
+}
+
+func TestSimpleReport_MarshalJSON(t *testing.T) {
+	t.Run("should marshal an empty report", func(t *testing.T) {
+		data, err := json.Marshal(NewSimpleReport())
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"errors": []}`, string(data))
+	})
+
+	t.Run("should marshal all items and the fatal error", func(t *testing.T) {
+		report := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+		report.AddFlaggedError(errReadError, ErrorMetadata{"filename": "text.txt"}, flagReadError)
+		report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+		data, err := json.Marshal(report)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"errors": [
+				{"message": "this simulates a read error", "flag": "read_error", "severity": "error", "metadata": {"filename": "text.txt"}, "time": "2020-04-01T12:00:00Z"},
+				{"message": "this simulates a write error", "flag": "write_error", "severity": "fatal", "time": "2020-04-01T12:00:00Z", "fatal": true}
+			],
+			"fatal_error": {"message": "this simulates a write error", "flag": "write_error", "severity": "fatal", "time": "2020-04-01T12:00:00Z", "fatal": true}
+		}`, string(data))
+	})
+}
+
+func TestSimpleReport_UnmarshalJSON(t *testing.T) {
+	t.Run("should return an error for invalid json", func(t *testing.T) {
+		report := NewSimpleReport()
+		assert.Error(t, json.Unmarshal([]byte(`{"errors": {}}`), report))
+	})
+
+	t.Run("should round-trip into a working report", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddFlaggedError(errReadError, ErrorMetadata{"filename": "text.txt"}, flagReadError)
+		report.AddFlaggedFatalError(fmt.Errorf("write: %w", errWriteError), nil, flagWriteError)
+		report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagReadError)
+		AddReportError(report, ReportError{
+			WrappedError: errIOError,
+			Attributes:   Attributes{"retries": Int64Value(3)},
+		})
+
+		data, err := json.Marshal(report)
+		require.NoError(t, err)
+
+		decodedReport := NewSimpleReport()
+		require.NoError(t, json.Unmarshal(data, decodedReport))
+
+		assert.Equal(t, 4, decodedReport.Count())
+		require.True(t, decodedReport.HasFatalError())
+		assert.Equal(t, "write: this simulates a write error", decodedReport.FatalError().Error())
+		assert.Equal(t, flagWriteError, decodedReport.FatalError().Flag)
+		assert.True(t, decodedReport.AllErrors()[1].Fatal)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, decodedReport.FirstError().Metadata)
+		assert.True(t, report.FirstError().Time.Equal(decodedReport.FirstError().Time))
+		assert.Equal(t, 1, decodedReport.FilterErrorsByFlag(flagIOError).Count())
+		assert.Equal(t, []ErrorFlag{flagReadError}, decodedReport.AllErrors()[2].Flags)
+		assert.Equal(t, Attributes{"retries": Int64Value(3)}, decodedReport.LastError().Attributes)
+
+		reEncoded, err := json.Marshal(decodedReport)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(data), string(reEncoded))
+	})
+
+	t.Run("should restore an empty report", func(t *testing.T) {
+		var report SimpleReport
+		require.NoError(t, json.Unmarshal([]byte(`{}`), &report))
+
+		assert.True(t, report.IsEmpty())
+		assert.False(t, report.HasFatalError())
+		assert.Equal(t, []ReportError{}, report.AllErrors())
+	})
+}
+
+func TestConcurrentReport_JSON(t *testing.T) {
+	report := NewConcurrentReport()
+	report.AddFlaggedFatalError(errReadError, nil, flagReadError)
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	var decodedReport ConcurrentReport
+	require.NoError(t, json.Unmarshal(data, &decodedReport))
+
+	assert.Equal(t, 1, decodedReport.Count())
+	assert.Equal(t, errReadError.Error(), decodedReport.FatalError().Error())
+}
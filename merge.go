@@ -0,0 +1,30 @@
+package yeterr
+
+// Merge adds all error items of the other report to this report. The items keep their time, flags, metadata and
+// occurrences. The fatal error of this report takes precedence: only when this report does not have a fatal error
+// yet, the fatal error of the other report becomes the fatal error of this report. Errors which were dropped by the
+// other report are counted as dropped by this report as well. The other report may be this report itself.
+func (s *SimpleReport) Merge(other Report) {
+	elements := append([]ReportError{}, other.AllErrors()...)
+	s.merge(elements, Dropped(other))
+}
+
+// merge inserts the elements of another report and adds its number of dropped errors.
+func (s *SimpleReport) merge(elements []ReportError, dropped int) {
+	for _, element := range elements {
+		s.insert(element)
+	}
+
+	s.dropped += dropped
+}
+
+// Merge adds all error items of the other report to this report. The fatal error of this report takes precedence
+// over the fatal error of the other report. The other report may be this report itself.
+func (c *ConcurrentReport) Merge(other Report) {
+	elements := other.AllErrors()
+	dropped := Dropped(other)
+
+	c.add(func(report *SimpleReport) {
+		report.merge(elements, dropped)
+	})
+}
@@ -0,0 +1,99 @@
+package yeterr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleReport_Merge(t *testing.T) {
+	t.Run("should add all items of the other report", func(t *testing.T) {
+		other := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+		other.AddFlaggedError(errReadError, ErrorMetadata{"filename": "text.txt"}, flagReadError)
+		other.AddMultiFlaggedError(errIOError, nil, flagIOError, flagWriteError)
+
+		report := NewSimpleReport()
+		report.AddError(errWriteError, nil)
+		report.Merge(other)
+
+		assert.Equal(t, 3, report.Count())
+		assert.Equal(t, other.AllErrors(), report.AllErrors()[1:])
+	})
+
+	t.Run("should take over the fatal error of the other report", func(t *testing.T) {
+		other := NewSimpleReport()
+		other.AddError(errReadError, nil)
+		other.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+		report := NewSimpleReport()
+		report.Merge(other)
+
+		require.True(t, report.HasFatalError())
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+		assert.True(t, report.AllErrors()[1].Fatal)
+	})
+
+	t.Run("should keep its own fatal error", func(t *testing.T) {
+		other := NewSimpleReport()
+		other.AddFatalError(errWriteError, nil)
+
+		report := NewSimpleReport()
+		report.AddFatalError(errReadError, nil)
+		report.Merge(other)
+
+		assert.Equal(t, errReadError, report.FatalError().Unwrap())
+		assert.False(t, report.LastError().Fatal)
+		assert.Equal(t, SeverityFatal, report.LastError().Severity)
+	})
+
+	t.Run("should prefer the fatal error of the other report over other fatal items", func(t *testing.T) {
+		other := NewSimpleReport(WithDeduplication(nil))
+		other.AddError(errReadError, nil)
+		other.AddFatalError(errWriteError, nil)
+		other.AddFatalError(errReadError, nil)
+
+		report := NewSimpleReport()
+		report.Merge(other)
+
+		assert.Equal(t, SeverityFatal, report.FirstError().Severity)
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+	})
+
+	t.Run("should keep occurrences and add dropped errors", func(t *testing.T) {
+		other := NewSimpleReport(WithDeduplication(nil), WithCapacity(1, OverflowReject))
+		other.AddError(errReadError, nil)
+		other.AddError(errReadError, nil)
+		other.AddError(errWriteError, nil)
+
+		report := NewSimpleReport()
+		report.Merge(other)
+
+		assert.Equal(t, 2, report.Count())
+		assert.Equal(t, 1, Dropped(report))
+	})
+
+	t.Run("should merge a report into itself", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddError(errReadError, nil)
+		report.AddFatalError(errWriteError, nil)
+		report.Merge(report)
+
+		assert.Equal(t, 4, report.Count())
+		assert.True(t, report.AllErrors()[1].Fatal)
+		assert.False(t, report.AllErrors()[3].Fatal)
+	})
+}
+
+func TestConcurrentReport_Merge(t *testing.T) {
+	other := NewSimpleReport()
+	other.AddFatalError(errWriteError, nil)
+
+	report := NewConcurrentReport()
+	report.AddError(errReadError, nil)
+	report.Merge(other)
+	report.Merge(report)
+
+	assert.Equal(t, 4, report.Count())
+	assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+}
@@ -0,0 +1,163 @@
+package yeterr
+
+import "errors"
+
+// ErrShouldStop is returned by AddAndCheck when the report should stop collecting errors.
+var ErrShouldStop = errors.New("yeterr: report should stop")
+
+// Policy is checked for every error added to a report before it is stored. A policy can escalate the error to a fatal
+// error by setting its severity to SeverityFatal. It returns true if the report should stop. A policy must not change
+// the report.
+type Policy func(report Report, reportErr *ReportError) bool
+
+// WithPolicies adds policies to the report. The policies are checked in the provided order.
+func WithPolicies(policies ...Policy) ReportOption {
+	return func(s *SimpleReport) {
+		s.policies = append(s.policies, policies...)
+	}
+}
+
+// MaxErrors returns a policy which stops the report when the total number of added errors, including duplicates and
+// dropped errors, reaches the maximum.
+func MaxErrors(maxErrors int) Policy {
+	return func(report Report, reportErr *ReportError) bool {
+		return report.Count()+Dropped(report)+reportErr.occurrences() >= maxErrors
+	}
+}
+
+// MaxErrorsPerFlag returns a policy which stops the report when the number of stored errors with the flag reaches the
+// maximum.
+func MaxErrorsPerFlag(flag ErrorFlag, maxErrors int) Policy {
+	return func(report Report, reportErr *ReportError) bool {
+		if !reportErr.HasFlag(flag) {
+			return false
+		}
+
+		return flagCount(report, flag)+reportErr.occurrences() >= maxErrors
+	}
+}
+
+// flagCount returns the number of stored errors with the flag. A SimpleReport keeps a counter per flag, other reports
+// are scanned.
+func flagCount(report Report, flag ErrorFlag) int {
+	if simpleReport, ok := report.(*SimpleReport); ok {
+		return simpleReport.flagCount(flag)
+	}
+
+	count := 0
+	for _, element := range report.AllErrors() {
+		if element.HasFlag(flag) {
+			count += element.occurrences()
+		}
+	}
+
+	return count
+}
+
+// flagCount returns the number of stored errors with the flag. The counters are built on first use and updated
+// whenever items are stored, deduplicated or evicted afterwards.
+func (s *SimpleReport) flagCount(flag ErrorFlag) int {
+	if s.flagCounts == nil {
+		s.flagCounts = map[ErrorFlag]int{}
+		for _, element := range s.elements {
+			s.countFlags(element, element.occurrences())
+		}
+	}
+
+	return s.flagCounts[flag]
+}
+
+// countFlags adds the occurrences to the counters of all flags of the item. It does nothing as long as the counters
+// are not built.
+func (s *SimpleReport) countFlags(element ReportError, occurrences int) {
+	if s.flagCounts == nil {
+		return
+	}
+
+	allFlags := element.AllFlags()
+	for i, flag := range allFlags {
+		if !containsFlag(allFlags[:i], flag) {
+			s.flagCounts[flag] += occurrences
+		}
+	}
+}
+
+// containsFlag returns true if the flag is one of the flags.
+func containsFlag(flags []ErrorFlag, flag ErrorFlag) bool {
+	for _, candidate := range flags {
+		if candidate == flag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FatalFlags returns a policy which escalates errors with one of the flags to fatal errors.
+func FatalFlags(flags ...ErrorFlag) Policy {
+	return FatalWhen(ByFlagMatch(FlagMatchAny, flags...))
+}
+
+// FatalWhen returns a policy which escalates errors matching the predicate to fatal errors.
+func FatalWhen(predicate Predicate) Policy {
+	return func(report Report, reportErr *ReportError) bool {
+		if predicate(*reportErr) {
+			reportErr.Severity = SeverityFatal
+		}
+
+		return false
+	}
+}
+
+// StopWhen returns a policy which stops the report when an error matching the predicate is added.
+func StopWhen(predicate Predicate) Policy {
+	return func(report Report, reportErr *ReportError) bool {
+		return predicate(*reportErr)
+	}
+}
+
+// applyPolicies checks all policies for the element. An element which was escalated to a fatal error becomes a
+// candidate for the fatal error of the report.
+func (s *SimpleReport) applyPolicies(element ReportError) ReportError {
+	severity := element.Severity
+	for _, policy := range s.policies {
+		if policy(s, &element) {
+			s.stopped = true
+		}
+	}
+
+	if element.Severity == SeverityFatal && severity != SeverityFatal {
+		element.Fatal = true
+	}
+
+	return element
+}
+
+// ShouldStop returns true if the report has a fatal error or one of its policies decided that the report should stop.
+func (s *SimpleReport) ShouldStop() bool {
+	return s.stopped || s.HasFatalError()
+}
+
+// AddAndCheck adds an error with the flags to the report like AddMultiFlaggedError. It returns ErrShouldStop if the
+// report should stop afterwards, so loops can break cleanly.
+func AddAndCheck(report Report, err error, metadata ErrorMetadata, flags ...ErrorFlag) error {
+	report.AddMultiFlaggedError(err, metadata, flags...)
+	if report.ShouldStop() {
+		return ErrShouldStop
+	}
+
+	return nil
+}
+
+// ShouldStop returns true if the report has a fatal error or one of its policies decided that the report should stop.
+func (c *ConcurrentReport) ShouldStop() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().ShouldStop()
+}
+
+// ShouldStop returns true if the parent should stop.
+func (c *scopedReport) ShouldStop() bool {
+	return c.parent.ShouldStop()
+}
@@ -0,0 +1,162 @@
+package yeterr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleReport_ShouldStop(t *testing.T) {
+	t.Run("should stop on a fatal error without policies", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddError(errReadError, nil)
+		assert.False(t, report.ShouldStop())
+
+		report.AddFatalError(errWriteError, nil)
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should stop after the maximum number of errors", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(MaxErrors(3)), WithDeduplication(nil))
+		report.AddError(errReadError, nil)
+		report.AddError(errReadError, nil)
+		assert.False(t, report.ShouldStop())
+
+		report.AddError(errReadError, nil)
+		assert.True(t, report.ShouldStop())
+		assert.False(t, report.HasFatalError())
+	})
+
+	t.Run("should count dropped errors for the maximum number of errors", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(MaxErrors(2)), WithCapacity(1, OverflowReject))
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should stop after the maximum number of errors per flag", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(MaxErrorsPerFlag(flagReadError, 2)))
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+		assert.False(t, report.ShouldStop())
+
+		report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagReadError)
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should count duplicates and forget evicted errors per flag", func(t *testing.T) {
+		report := NewSimpleReport(
+			WithPolicies(MaxErrorsPerFlag(flagReadError, 4)),
+			WithDeduplication(nil),
+			WithCapacity(2, OverflowDropOldest),
+		)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+		assert.Equal(t, 0, report.(*SimpleReport).flagCount(flagReadError))
+
+		report.AddMultiFlaggedError(errReadError, nil, flagReadError, flagReadError)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		assert.False(t, report.ShouldStop())
+		assert.Equal(t, 3, report.(*SimpleReport).flagCount(flagReadError))
+
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should stop when an error matches the predicate", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(StopWhen(BySeverityAtLeast(SeverityCritical))))
+		report.AddError(errReadError, nil)
+		assert.False(t, report.ShouldStop())
+
+		report.AddWithSeverity(errWriteError, nil, SeverityCritical)
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should keep the policies for filtered reports", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(MaxErrors(1)))
+		filteredReport := report.FilterErrorsByFlag(flagReadError)
+		filteredReport.AddError(errReadError, nil)
+
+		assert.True(t, filteredReport.ShouldStop())
+		assert.False(t, report.ShouldStop())
+	})
+}
+
+func TestFatalFlags(t *testing.T) {
+	t.Run("should escalate errors with the flags to fatal errors", func(t *testing.T) {
+		report := NewSimpleReport(WithPolicies(FatalFlags(flagIOError)))
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddMultiFlaggedError(errIOError, nil, flagReadError, flagIOError)
+		report.AddFlaggedError(errWriteError, nil, flagIOError)
+
+		require.True(t, report.HasFatalError())
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+		assert.Equal(t, SeverityFatal, report.FatalError().Severity)
+		assert.True(t, report.AllErrors()[1].Fatal)
+		assert.Equal(t, SeverityFatal, report.LastError().Severity)
+		assert.False(t, report.LastError().Fatal)
+		assert.True(t, report.ShouldStop())
+	})
+
+	t.Run("should not escalate merged fatal items over the fatal error of the other report", func(t *testing.T) {
+		other := NewSimpleReport()
+		other.AddFatalError(errWriteError, nil)
+
+		report := NewSimpleReport(WithPolicies(FatalFlags(flagIOError)))
+		report.Merge(other)
+		report.AddFlaggedError(errIOError, nil, flagIOError)
+
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+	})
+}
+
+func TestFatalWhen(t *testing.T) {
+	report := NewSimpleReport(WithPolicies(FatalWhen(ByErrorIs(errWriteError))))
+	report.AddError(errReadError, nil)
+	report.AddError(errWriteError, nil)
+
+	require.True(t, report.HasFatalError())
+	assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+}
+
+func TestSimpleReport_AddAndCheck(t *testing.T) {
+	report := NewSimpleReport(WithPolicies(MaxErrors(3)))
+
+	added := 0
+	for i := 0; i < 10; i++ {
+		err := AddAndCheck(report, errReadError, nil, flagReadError)
+		added++
+		if errors.Is(err, ErrShouldStop) {
+			break
+		}
+
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 3, added)
+	assert.Equal(t, 3, report.FilterErrorsByFlag(flagReadError).Count())
+}
+
+func TestConcurrentReport_Policies(t *testing.T) {
+	report := NewConcurrentReport(WithPolicies(FatalFlags(flagIOError)))
+	assert.NoError(t, AddAndCheck(report, errReadError, nil))
+	assert.Equal(t, ErrShouldStop, AddAndCheck(report, errIOError, nil, flagIOError))
+	assert.True(t, report.ShouldStop())
+}
+
+func TestScopedReport_Policies(t *testing.T) {
+	report := NewSimpleReport(WithPolicies(MaxErrors(2)))
+	child := report.Child("import")
+
+	assert.NoError(t, AddAndCheck(child, errReadError, nil))
+	assert.Equal(t, ErrShouldStop, AddAndCheck(child, errReadError, nil))
+	assert.True(t, child.ShouldStop())
+	assert.Equal(t, "import", report.LastError().Scope)
+}
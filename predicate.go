@@ -0,0 +1,112 @@
+package yeterr
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+// Predicate decides whether an error item matches. Predicates can be combined with And, Or and Not.
+type Predicate func(ReportError) bool
+
+// ByFlag returns a predicate matching error items which have the flag.
+func ByFlag(flag ErrorFlag) Predicate {
+	return func(reportErr ReportError) bool {
+		return reportErr.HasFlag(flag)
+	}
+}
+
+// ByFlagMatch returns a predicate matching error items whose flags match the provided flags according to the match
+// mode.
+func ByFlagMatch(match FlagMatch, flags ...ErrorFlag) Predicate {
+	return func(reportErr ReportError) bool {
+		return reportErr.matchesFlags(match, flags)
+	}
+}
+
+// BySeverityAtLeast returns a predicate matching error items which have the severity or a more serious one.
+func BySeverityAtLeast(severity Severity) Predicate {
+	return func(reportErr ReportError) bool {
+		return reportErr.Severity >= severity
+	}
+}
+
+// ByTimeRange returns a predicate matching error items which were added in the time range. The range includes from
+// and excludes to. A zero from or to leaves the range open on that side.
+func ByTimeRange(from time.Time, to time.Time) Predicate {
+	return func(reportErr ReportError) bool {
+		return (from.IsZero() || !reportErr.Time.Before(from)) && (to.IsZero() || reportErr.Time.Before(to))
+	}
+}
+
+// ByMetadataKey returns a predicate matching error items which have the key in their metadata or attributes.
+func ByMetadataKey(key string) Predicate {
+	return func(reportErr ReportError) bool {
+		_, ok := reportErr.Attribute(key)
+		return ok
+	}
+}
+
+// ByMetadata returns a predicate matching error items which have the key with the value in their metadata or
+// attributes. Attributes are compared by their string representation.
+func ByMetadata(key string, value string) Predicate {
+	return func(reportErr ReportError) bool {
+		attribute, ok := reportErr.Attribute(key)
+		return ok && attribute.String() == value
+	}
+}
+
+// ByErrorIs returns a predicate matching error items whose wrapped error matches the target according to errors.Is.
+func ByErrorIs(target error) Predicate {
+	return func(reportErr ReportError) bool {
+		return errors.Is(reportErr.WrappedError, target)
+	}
+}
+
+// ByErrorAs returns a predicate matching error items whose wrapped error matches the type T according to errors.As.
+func ByErrorAs[T error]() Predicate {
+	return func(reportErr ReportError) bool {
+		var target T
+		return errors.As(reportErr.WrappedError, &target)
+	}
+}
+
+// ByMessage returns a predicate matching error items whose error message matches the regular expression.
+func ByMessage(pattern *regexp.Regexp) Predicate {
+	return func(reportErr ReportError) bool {
+		return reportErr.WrappedError != nil && pattern.MatchString(reportErr.Error())
+	}
+}
+
+// And returns a predicate matching error items which match all of the predicates.
+func And(predicates ...Predicate) Predicate {
+	return func(reportErr ReportError) bool {
+		for _, predicate := range predicates {
+			if !predicate(reportErr) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or returns a predicate matching error items which match at least one of the predicates.
+func Or(predicates ...Predicate) Predicate {
+	return func(reportErr ReportError) bool {
+		for _, predicate := range predicates {
+			if predicate(reportErr) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not returns a predicate matching error items which do not match the predicate.
+func Not(predicate Predicate) Predicate {
+	return func(reportErr ReportError) bool {
+		return !predicate(reportErr)
+	}
+}
@@ -0,0 +1,89 @@
+package yeterr
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	predicateReadError = ReportError{
+		WrappedError: errReadError,
+		Metadata:     ErrorMetadata{"filename": "text.txt"},
+		Flag:         flagReadError,
+		Flags:        []ErrorFlag{flagIOError},
+		Severity:     SeverityWarning,
+	}
+	predicatePathError = ReportError{
+		WrappedError: fmt.Errorf("open: %w", &os.PathError{Op: "open", Path: "data.csv", Err: os.ErrNotExist}),
+		Attributes:   Attributes{"retries": Int64Value(3)},
+		Flag:         flagIOError,
+		Severity:     SeverityCritical,
+	}
+)
+
+func TestByFlag(t *testing.T) {
+	assert.True(t, ByFlag(flagIOError)(predicateReadError))
+	assert.False(t, ByFlag(flagWriteError)(predicateReadError))
+}
+
+func TestByFlagMatch(t *testing.T) {
+	assert.True(t, ByFlagMatch(FlagMatchAll, flagReadError, flagIOError)(predicateReadError))
+	assert.False(t, ByFlagMatch(FlagMatchAll, flagReadError, flagIOError)(predicatePathError))
+	assert.True(t, ByFlagMatch(FlagMatchNone, flagWriteError)(predicatePathError))
+}
+
+func TestBySeverityAtLeast(t *testing.T) {
+	assert.False(t, BySeverityAtLeast(SeverityError)(predicateReadError))
+	assert.True(t, BySeverityAtLeast(SeverityError)(predicatePathError))
+}
+
+func TestByMetadataKey(t *testing.T) {
+	assert.True(t, ByMetadataKey("filename")(predicateReadError))
+	assert.True(t, ByMetadataKey("retries")(predicatePathError))
+	assert.False(t, ByMetadataKey("retries")(predicateReadError))
+}
+
+func TestByMetadata(t *testing.T) {
+	assert.True(t, ByMetadata("filename", "text.txt")(predicateReadError))
+	assert.False(t, ByMetadata("filename", "data.csv")(predicateReadError))
+	assert.True(t, ByMetadata("retries", "3")(predicatePathError))
+}
+
+func TestByErrorIs(t *testing.T) {
+	assert.True(t, ByErrorIs(os.ErrNotExist)(predicatePathError))
+	assert.False(t, ByErrorIs(os.ErrNotExist)(predicateReadError))
+}
+
+func TestByErrorAs(t *testing.T) {
+	assert.True(t, ByErrorAs[*os.PathError]()(predicatePathError))
+	assert.False(t, ByErrorAs[*os.PathError]()(predicateReadError))
+}
+
+func TestByMessage(t *testing.T) {
+	assert.True(t, ByMessage(regexp.MustCompile(`^open: .*data\.csv`))(predicatePathError))
+	assert.False(t, ByMessage(regexp.MustCompile(`write`))(predicateReadError))
+	assert.False(t, ByMessage(regexp.MustCompile(`.*`))(ReportError{}))
+}
+
+func TestAnd_Or_Not(t *testing.T) {
+	t.Run("should match all predicates with And", func(t *testing.T) {
+		assert.True(t, And(ByFlag(flagIOError), BySeverityAtLeast(SeverityCritical))(predicatePathError))
+		assert.False(t, And(ByFlag(flagIOError), BySeverityAtLeast(SeverityCritical))(predicateReadError))
+		assert.True(t, And()(predicateReadError))
+	})
+
+	t.Run("should match any predicate with Or", func(t *testing.T) {
+		assert.True(t, Or(ByFlag(flagWriteError), ByMetadataKey("filename"))(predicateReadError))
+		assert.False(t, Or(ByFlag(flagWriteError), ByMetadataKey("filename"))(predicatePathError))
+		assert.False(t, Or()(predicateReadError))
+	})
+
+	t.Run("should negate a predicate with Not", func(t *testing.T) {
+		assert.False(t, Not(ByFlag(flagIOError))(predicateReadError))
+		assert.True(t, Not(ByFlag(flagWriteError))(predicateReadError))
+	})
+}
@@ -0,0 +1,317 @@
+package yeterr
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces redacted values.
+const RedactedValue = "[REDACTED]"
+
+var (
+	emailPattern      = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	creditCardPattern = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+)
+
+// Redaction returns the redacted value for the metadata key and value. The key is empty for error messages. It
+// returns the value unchanged if nothing has to be redacted.
+type Redaction func(key string, value string) string
+
+// RedactKeys returns a redaction which redacts the whole value of metadata keys containing one of the keys, e.g.
+// "token" redacts "access_token" as well. Keys are matched case-insensitively.
+func RedactKeys(keys ...string) Redaction {
+	lowerKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lowerKeys = append(lowerKeys, strings.ToLower(key))
+	}
+
+	return func(key string, value string) string {
+		if key == "" {
+			return value
+		}
+
+		key = strings.ToLower(key)
+		for _, lowerKey := range lowerKeys {
+			if strings.Contains(key, lowerKey) {
+				return RedactedValue
+			}
+		}
+
+		return value
+	}
+}
+
+// RedactPattern returns a redaction which redacts all matches of the regular expression in values and error messages.
+func RedactPattern(pattern *regexp.Regexp) Redaction {
+	return func(key string, value string) string {
+		return pattern.ReplaceAllString(value, RedactedValue)
+	}
+}
+
+// RedactEmails returns a redaction which redacts email addresses in values and error messages.
+func RedactEmails() Redaction {
+	return RedactPattern(emailPattern)
+}
+
+// RedactCreditCards returns a redaction which redacts credit card numbers in values and error messages. Only numbers
+// with a valid Luhn checksum are redacted, digits may be separated by spaces or dashes.
+func RedactCreditCards() Redaction {
+	return func(key string, value string) string {
+		return creditCardPattern.ReplaceAllStringFunc(value, func(match string) string {
+			if !isLuhnValid(match) {
+				return match
+			}
+
+			return RedactedValue
+		})
+	}
+}
+
+// isLuhnValid returns true if the digits of the number have a valid Luhn checksum. Other characters are ignored.
+func isLuhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			continue
+		}
+
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// Redactor applies redactions to the messages, metadata and attributes of error items.
+type Redactor struct {
+	redactions []Redaction
+}
+
+// NewRedactor creates a redactor which applies the redactions in the provided order.
+func NewRedactor(redactions ...Redaction) *Redactor {
+	return &Redactor{
+		redactions: redactions,
+	}
+}
+
+// WithRedactor redacts the report whenever it is exported: by Error, by the fmt verbs, as JSON, as slog value, by
+// renderers which use Redacted and by every method returning error items, e.g. AllErrors, FatalError or Unwrap. Hooks
+// get redacted items as well. The report stores the original items: queries which do not return items, e.g.
+// HighestSeverity or Contains, read them without redacting, and policies get the checked item unredacted. The
+// report passed to a policy still returns redacted items.
+func WithRedactor(redactor *Redactor) ReportOption {
+	return func(s *SimpleReport) {
+		s.redactor = redactor
+	}
+}
+
+// RedactError returns a copy of the error item with redacted message, metadata and attributes. The messages of the
+// unwrap chain are redacted as well, errors.Is and errors.As keep working on the original errors.
+func (r *Redactor) RedactError(reportErr ReportError) ReportError {
+	if r == nil || len(r.redactions) == 0 {
+		return reportErr
+	}
+
+	reportErr.WrappedError = r.redactWrappedError(reportErr.WrappedError)
+
+	if reportErr.Metadata != nil {
+		metadata := make(ErrorMetadata, len(reportErr.Metadata))
+		for key, value := range reportErr.Metadata {
+			metadata[key] = r.redact(key, value)
+		}
+
+		reportErr.Metadata = metadata
+	}
+
+	if reportErr.Attributes != nil {
+		reportErr.Attributes = r.redactAttributes(reportErr.Attributes)
+	}
+
+	return reportErr
+}
+
+// redact applies all redactions to the value.
+func (r *Redactor) redact(key string, value string) string {
+	for _, redaction := range r.redactions {
+		value = redaction(key, value)
+	}
+
+	return value
+}
+
+// redactAttributes returns a copy of the attributes with redacted values.
+func (r *Redactor) redactAttributes(attributes Attributes) Attributes {
+	redactedAttributes := make(Attributes, len(attributes))
+	for key, value := range attributes {
+		redactedAttributes[key] = r.redactValue(key, value)
+	}
+
+	return redactedAttributes
+}
+
+// redactValue returns the redacted value. Values of maps are redacted with their own keys, values of slices with the
+// key of the slice. Other values than strings become string values when they are redacted.
+func (r *Redactor) redactValue(key string, value Value) Value {
+	switch value.Kind() {
+	case KindMap:
+		attributes, _ := value.AsMap()
+		return MapValue(r.redactAttributes(attributes))
+	case KindSlice:
+		items, _ := value.AsSlice()
+		redactedItems := make([]Value, 0, len(items))
+		for _, item := range items {
+			redactedItems = append(redactedItems, r.redactValue(key, item))
+		}
+
+		return SliceValue(redactedItems...)
+	default:
+		original := value.String()
+		if redacted := r.redact(key, original); redacted != original {
+			return StringValue(redacted)
+		}
+
+		return value
+	}
+}
+
+// redactWrappedError returns the error with redacted messages. It returns the error itself if none of the messages of
+// its unwrap chain is redacted.
+func (r *Redactor) redactWrappedError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var chain []error
+	changed := false
+	for current := err; current != nil; current = errors.Unwrap(current) {
+		chain = append(chain, current)
+		changed = changed || r.redact("", current.Error()) != current.Error()
+	}
+
+	if !changed {
+		return err
+	}
+
+	var redactedErr *redactedError
+	for i := len(chain) - 1; i >= 0; i-- {
+		redactedErr = &redactedError{
+			message:  r.redact("", chain[i].Error()),
+			original: chain[i],
+			wrapped:  redactedErr,
+		}
+	}
+
+	return redactedErr
+}
+
+// redactedError is an error with a redacted message. It unwraps to the redacted next error of the original unwrap
+// chain and matches the original error with errors.Is and errors.As.
+type redactedError struct {
+	message  string
+	original error
+	wrapped  *redactedError
+}
+
+// Error implements the error interface.
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the redacted next error of the unwrap chain.
+func (e *redactedError) Unwrap() error {
+	if e.wrapped == nil {
+		return nil
+	}
+
+	return e.wrapped
+}
+
+// Is reports whether the original error matches the target.
+func (e *redactedError) Is(target error) bool {
+	return errors.Is(e.original, target)
+}
+
+// As finds the first error in the unwrap chain of the original error which matches the target.
+func (e *redactedError) As(target interface{}) bool {
+	return errors.As(e.original, target)
+}
+
+// Redacted returns a copy of the report with redacted error items. Without a redactor, the copy has the same error
+// items as the report. The copy is not redacted again when it is exported. Reports which are not implemented by this
+// package are returned unchanged.
+func Redacted(report Report) Report {
+	if _, ok := report.(reader); !ok {
+		return report
+	}
+
+	var redactedReport *SimpleReport
+	readReport(report, func(s *SimpleReport) {
+		redactedReport = s.redacted()
+	})
+
+	return wrapReport(report, redactedReport)
+}
+
+// redacted returns a copy of the report with redacted error items and without redactor.
+func (s *SimpleReport) redacted() *SimpleReport {
+	redactedReport := s.newFilteredReport()
+	redactedReport.redactor = nil
+	redactedReport.dropped = s.dropped
+
+	for _, element := range s.items() {
+		redactedReport.elements = append(redactedReport.elements, s.redactor.RedactError(element))
+	}
+
+	if s.HasFatalError() {
+		fatalError := s.redactor.RedactError(*s.fatalError)
+		redactedReport.fatalError = &fatalError
+	}
+
+	return redactedReport
+}
+
+// exportedError returns the item as it is exported: a redacted copy if the report has a redactor, otherwise the item
+// itself.
+func (s *SimpleReport) exportedError(reportErr *ReportError) *ReportError {
+	if s.redactor == nil || reportErr == nil {
+		return reportErr
+	}
+
+	redactedErr := s.redactor.RedactError(*reportErr)
+	return &redactedErr
+}
+
+// exportedItems returns the items in the order they were added as they are exported: redacted copies if the report
+// has a redactor, otherwise the items themselves.
+func (s *SimpleReport) exportedItems() []ReportError {
+	if s.redactor == nil {
+		return s.items()
+	}
+
+	items := make([]ReportError, 0, len(s.elements))
+	for position := range s.elements {
+		items = append(items, s.redactor.RedactError(s.elements[s.index(position)]))
+	}
+
+	return items
+}
+
+// exported returns the report which is exported: the redacted copy if the report has a redactor, otherwise the
+// report itself.
+func (s *SimpleReport) exported() *SimpleReport {
+	if s.redactor == nil {
+		return s
+	}
+
+	return s.redacted()
+}
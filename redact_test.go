@@ -0,0 +1,262 @@
+package yeterr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errLogin = errors.New("login of alice@example.com failed")
+
+func newTestRedactor() *Redactor {
+	return NewRedactor(RedactKeys("password", "token"), RedactEmails(), RedactCreditCards())
+}
+
+func TestRedactKeys(t *testing.T) {
+	redaction := RedactKeys("token")
+
+	assert.Equal(t, RedactedValue, redaction("Access_Token", "abc"))
+	assert.Equal(t, "abc", redaction("user", "abc"))
+	assert.Equal(t, "token", redaction("", "token"))
+}
+
+func TestRedactPattern(t *testing.T) {
+	redaction := RedactPattern(regexp.MustCompile(`/home/[^/\s]+`))
+	assert.Equal(t, "cannot open [REDACTED]/.ssh/id_rsa", redaction("", "cannot open /home/alice/.ssh/id_rsa"))
+}
+
+func TestRedactEmails(t *testing.T) {
+	redacted := RedactEmails()("", "contact alice@example.com or b.ob+x@mail.co.uk")
+	assert.Equal(t, "contact [REDACTED] or [REDACTED]", redacted)
+}
+
+func TestRedactCreditCards(t *testing.T) {
+	redaction := RedactCreditCards()
+
+	assert.Equal(t, "card [REDACTED] declined", redaction("", "card 4111 1111 1111 1111 declined"))
+	assert.Equal(t, "card [REDACTED] declined", redaction("", "card 5500-0000-0000-0004 declined"))
+	assert.Equal(t, "order 1234567890123 failed", redaction("", "order 1234567890123 failed"))
+}
+
+func TestRedactor_RedactError(t *testing.T) {
+	t.Run("should redact metadata, attributes and the message", func(t *testing.T) {
+		reportErr := ReportError{
+			WrappedError: fmt.Errorf("request failed: %w", errLogin),
+			Metadata:     ErrorMetadata{"password": "secret", "user": "alice@example.com", "id": "7"},
+			Attributes: Attributes{
+				"api_token": IntValue(1234),
+				"request":   MapValue(Attributes{"token": StringValue("abc"), "method": StringValue("POST")}),
+				"cards":     SliceValue(StringValue("4111111111111111"), StringValue("none")),
+			},
+		}
+
+		redacted := newTestRedactor().RedactError(reportErr)
+
+		assert.Equal(t, "request failed: login of [REDACTED] failed", redacted.Error())
+		assert.Equal(t, "login of [REDACTED] failed", errors.Unwrap(redacted.WrappedError).Error())
+		assert.True(t, errors.Is(redacted, errLogin))
+		assert.Equal(t, ErrorMetadata{"password": RedactedValue, "user": RedactedValue, "id": "7"}, redacted.Metadata)
+
+		apiToken, ok := redacted.Attributes.GetString("api_token")
+		require.True(t, ok)
+		assert.Equal(t, RedactedValue, apiToken)
+
+		request, ok := redacted.Attributes["request"].AsMap()
+		require.True(t, ok)
+		assert.Equal(t, StringValue(RedactedValue), request["token"])
+		assert.Equal(t, StringValue("POST"), request["method"])
+
+		cards, ok := redacted.Attributes["cards"].AsSlice()
+		require.True(t, ok)
+		assert.Equal(t, []Value{StringValue(RedactedValue), StringValue("none")}, cards)
+	})
+
+	t.Run("should keep the original error item unchanged", func(t *testing.T) {
+		reportErr := ReportError{WrappedError: errLogin, Metadata: ErrorMetadata{"password": "secret"}}
+		newTestRedactor().RedactError(reportErr)
+
+		assert.Equal(t, errLogin, reportErr.WrappedError)
+		assert.Equal(t, "secret", reportErr.Metadata["password"])
+	})
+
+	t.Run("should keep errors without sensitive messages", func(t *testing.T) {
+		redacted := newTestRedactor().RedactError(ReportError{WrappedError: errReadError})
+		assert.Equal(t, errReadError, redacted.WrappedError)
+	})
+
+	t.Run("should keep error items without redactor", func(t *testing.T) {
+		var redactor *Redactor
+		reportErr := ReportError{WrappedError: errLogin}
+
+		assert.Equal(t, reportErr, redactor.RedactError(reportErr))
+	})
+}
+
+func TestSimpleReport_Redacted(t *testing.T) {
+	t.Run("should return a redacted copy", func(t *testing.T) {
+		report := NewSimpleReport(WithRedactor(newTestRedactor()))
+		report.AddError(errReadError, ErrorMetadata{"token": "abc"})
+		report.AddFatalError(errLogin, nil)
+
+		redactedReport := Redacted(report)
+
+		require.Equal(t, 2, redactedReport.Count())
+		assert.Equal(t, RedactedValue, redactedReport.FirstError().Metadata["token"])
+		assert.Equal(t, "login of [REDACTED] failed", redactedReport.FatalError().Error())
+		assert.True(t, Contains(redactedReport, errLogin))
+		assert.Equal(t, "abc", report.(*SimpleReport).elements[0].Metadata["token"])
+		assert.Equal(t, errLogin, report.(*SimpleReport).fatalError.Unwrap())
+	})
+
+	t.Run("should copy a report without redactor", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddError(errLogin, nil)
+
+		assert.Equal(t, report.AllErrors(), Redacted(report).AllErrors())
+	})
+
+	t.Run("should redact concurrent and child reports", func(t *testing.T) {
+		report := NewConcurrentReport(WithRedactor(newTestRedactor()))
+		report.Child("auth").AddError(errLogin, nil)
+
+		assert.Equal(t, "login of [REDACTED] failed", Redacted(report).FirstError().Error())
+		assert.Equal(t, "login of [REDACTED] failed", Redacted(report.Child("auth")).FirstError().Error())
+	})
+}
+
+func TestWithRedactor(t *testing.T) {
+	report := NewSimpleReport(WithRedactor(newTestRedactor()), WithSummary(FlagSummary))
+	report.AddFlaggedError(errReadError, ErrorMetadata{"password": "secret"}, flagReadError)
+	report.AddFatalError(errLogin, nil)
+
+	t.Run("should redact the message", func(t *testing.T) {
+		assert.Equal(t, "report contains 2 error(s) [none: 1, read_error: 1], fatal: login of [REDACTED] failed",
+			report.Error())
+	})
+
+	t.Run("should redact formatted output", func(t *testing.T) {
+		formatted := fmt.Sprintf("%+v", report)
+		assert.Contains(t, formatted, "password:"+RedactedValue)
+		assert.NotContains(t, formatted, "secret")
+		assert.NotContains(t, formatted, "alice@example.com")
+		assert.NotContains(t, fmt.Sprintf("%#v", report), "secret")
+	})
+
+	t.Run("should redact JSON", func(t *testing.T) {
+		data, err := json.Marshal(report)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret")
+		assert.NotContains(t, string(data), "alice@example.com")
+	})
+
+	t.Run("should redact log values", func(t *testing.T) {
+		var builder strings.Builder
+		slog.New(slog.NewTextHandler(&builder, nil)).Error("failed", "report", report)
+
+		assert.Contains(t, builder.String(), RedactedValue)
+		assert.NotContains(t, builder.String(), "secret")
+		assert.NotContains(t, builder.String(), "alice@example.com")
+	})
+}
+
+func TestWithRedactor_errorItems(t *testing.T) {
+	newReport := func(options ...ReportOption) Report {
+		report := NewSimpleReport(append([]ReportOption{WithRedactor(newTestRedactor())}, options...)...)
+		report.AddFlaggedError(errReadError, ErrorMetadata{"password": "secret"}, flagReadError)
+		report.AddFatalError(errLogin, ErrorMetadata{"token": "abc"})
+		return report
+	}
+
+	// assertRedacted checks every way an item can leave the package: fmt, JSON and slog.
+	assertRedacted := func(t *testing.T, reportErr ReportError) {
+		var builder strings.Builder
+		slog.New(slog.NewTextHandler(&builder, nil)).Error("failed", "err", reportErr)
+		data, err := json.Marshal(reportErr)
+		require.NoError(t, err)
+
+		for _, exported := range []string{fmt.Sprintf("%+v", reportErr), string(data), builder.String()} {
+			assert.NotContains(t, exported, "secret")
+			assert.NotContains(t, exported, "abc")
+			assert.NotContains(t, exported, "alice@example.com")
+		}
+	}
+
+	t.Run("should redact the fatal error", func(t *testing.T) {
+		fatalError := newReport().FatalError()
+		require.NotNil(t, fatalError)
+		assertRedacted(t, *fatalError)
+		assert.True(t, errors.Is(fatalError, errLogin))
+	})
+
+	t.Run("should redact the first and the last error", func(t *testing.T) {
+		report := newReport()
+		assertRedacted(t, *report.FirstError())
+		assertRedacted(t, *report.LastError())
+	})
+
+	t.Run("should redact all errors", func(t *testing.T) {
+		for _, reportErr := range newReport().AllErrors() {
+			assertRedacted(t, reportErr)
+		}
+	})
+
+	t.Run("should redact unwrapped errors and the error slice", func(t *testing.T) {
+		report := newReport()
+		for _, err := range report.(*SimpleReport).Unwrap() {
+			assertRedacted(t, err.(ReportError))
+		}
+
+		errSlice := report.ToErrorSlice()
+		require.Len(t, errSlice, 2)
+		assert.Equal(t, "login of [REDACTED] failed", errSlice[1].Error())
+		assert.True(t, errors.Is(errSlice[1], errLogin))
+	})
+
+	t.Run("should redact the items of concurrent and child reports", func(t *testing.T) {
+		report := NewConcurrentReport(WithRedactor(newTestRedactor()))
+		report.Child("auth").AddFatalError(errLogin, ErrorMetadata{"token": "abc"})
+
+		assertRedacted(t, *report.FatalError())
+		assertRedacted(t, report.AllErrors()[0])
+		assertRedacted(t, *report.Child("auth").FirstError())
+	})
+
+	t.Run("should redact the items passed to hooks", func(t *testing.T) {
+		report := NewSimpleReport(WithRedactor(newTestRedactor()))
+		var added, fatal []ReportError
+		report.OnAdd(func(reportErr ReportError) { added = append(added, reportErr) })
+		report.OnFatal(func(reportErr ReportError) { fatal = append(fatal, reportErr) })
+		report.AddFatalError(errLogin, ErrorMetadata{"token": "abc"})
+
+		require.Len(t, added, 1)
+		require.Len(t, fatal, 1)
+		assertRedacted(t, added[0])
+		assertRedacted(t, fatal[0])
+	})
+
+	t.Run("should group the items by their redacted values", func(t *testing.T) {
+		report := newReport()
+		report.AddFlaggedError(errWriteError, ErrorMetadata{"password": "other"}, flagReadError)
+
+		flagGroups := GroupByFlag(report)
+		require.Len(t, flagGroups, 2)
+		assert.Equal(t, 2, flagGroups[flagReadError].Count())
+		assert.True(t, flagGroups[ErrorFlagNone].HasFatalError())
+
+		metadataGroups := GroupByMetadata(report, "password")
+		require.Equal(t, []string{RedactedValue}, SortedKeys(metadataGroups))
+		require.Equal(t, 2, metadataGroups[RedactedValue].Count())
+		for _, reportErr := range metadataGroups[RedactedValue].AllErrors() {
+			assertRedacted(t, reportErr)
+		}
+
+		assertRedacted(t, *flagGroups[ErrorFlagNone].FatalError())
+	})
+}
@@ -1,10 +1,13 @@
 package yeterr
 
 import (
-	"fmt"
+	"errors"
+	"sort"
+	"time"
 )
 
-// Report is an interface for a data structure which can collect errors with metadata and flags.
+// Report is an interface for a data structure which can collect errors with metadata and flags. Queries which can be
+// derived from the error items, e.g. HighestSeverity or GroupByFlag, are package level functions over Report.
 type Report interface {
 	IsEmpty() bool
 	HasErrors() bool
@@ -14,9 +17,20 @@ type Report interface {
 	AddFatalError(err error, metadata ErrorMetadata)
 	AddFlaggedError(err error, metadata ErrorMetadata, flag ErrorFlag)
 	AddFlaggedFatalError(err error, metadata ErrorMetadata, flag ErrorFlag)
+	AddMultiFlaggedError(err error, metadata ErrorMetadata, flags ...ErrorFlag)
+	AddMultiFlaggedFatalError(err error, metadata ErrorMetadata, flags ...ErrorFlag)
+	AddWithSeverity(err error, metadata ErrorMetadata, severity Severity)
+	AddFlaggedWithSeverity(err error, metadata ErrorMetadata, flag ErrorFlag, severity Severity)
+	Merge(other Report)
+	Child(name string) Report
+	WithMetadata(metadata ErrorMetadata) Report
+	OnAdd(hook func(ReportError)) (remove func())
+	OnFatal(hook func(ReportError)) (remove func())
+	ShouldStop() bool
 	AllErrors() []ReportError
 	FirstError() *ReportError
 	LastError() *ReportError
+	Filter(predicate Predicate) Report
 	FilterErrorsByFlag(flag ErrorFlag) Report
 	FilterErrorsByFlags(flags ...ErrorFlag) Report
 	ExcludeErrorsByFlag(flag ErrorFlag) Report
@@ -26,11 +40,87 @@ type Report interface {
 	error
 }
 
+// reader is implemented by the reports of this package. It gives package functions access to the stored items without
+// copying or redacting them.
+type reader interface {
+	// read calls fn with the SimpleReport which holds the stored items. The items do not change while fn runs, so fn
+	// must not call methods of the report.
+	read(fn func(s *SimpleReport))
+	// wrap returns a report created from the SimpleReport passed to read as report of the same kind, e.g. as
+	// ConcurrentReport.
+	wrap(report *SimpleReport) Report
+}
+
+// readReport calls fn with the SimpleReport which holds the stored items of the report. Reports of other packages are
+// read as a SimpleReport with their items and fatal error.
+func readReport(report Report, fn func(s *SimpleReport)) {
+	if r, ok := report.(reader); ok {
+		r.read(fn)
+		return
+	}
+
+	fn(&SimpleReport{
+		elements:   report.AllErrors(),
+		fatalError: report.FatalError(),
+	})
+}
+
+// wrapReport returns a report created from the SimpleReport read by readReport as report of the same kind as the
+// report.
+func wrapReport(report Report, created *SimpleReport) Report {
+	if r, ok := report.(reader); ok {
+		return r.wrap(created)
+	}
+
+	return created
+}
+
+// extendedReport has the methods which all reports of this package implement besides the methods of Report.
+type extendedReport interface {
+	Report
+	addReportError(reportErr ReportError)
+	FilterErrorsByFlagMatch(match FlagMatch, flags ...ErrorFlag) Report
+	FilterBySeverityAtLeast(severity Severity) Report
+	FilterByTimeRange(from time.Time, to time.Time) Report
+	Unwrap() []error
+	reader
+}
+
+var (
+	_ extendedReport = (*SimpleReport)(nil)
+	_ extendedReport = (*ConcurrentReport)(nil)
+	_ extendedReport = (*scopedReport)(nil)
+)
+
 // ReportError is a specific item of an error report.
 type ReportError struct {
 	WrappedError error
 	Metadata     ErrorMetadata
-	Flag         ErrorFlag
+	// Attributes is typed metadata of the item. It can be used next to Metadata.
+	Attributes Attributes
+	// MessageKey is the key of the message template which localizes the item. The template parameters are taken from
+	// Metadata and Attributes. Empty if the item does not have a localized message.
+	MessageKey string
+	Flag       ErrorFlag
+	// Flags are additional flags of the item besides Flag.
+	Flags    []ErrorFlag
+	Severity Severity
+	// Scope is the path of the child report which added the item, e.g. "import/users/row-12". Empty for items which
+	// were added to the report itself.
+	Scope string
+	// Time is the point in time when the item was added to the report.
+	Time time.Time
+	// LastSeen is the point in time when a duplicate of the item was added last to a deduplicating report.
+	LastSeen time.Time
+	// Occurrences is the number of times the item was added to a deduplicating report. Zero for reports which do
+	// not deduplicate, the item was added once then.
+	Occurrences int
+	// Fatal is true if the item is the fatal error of its report.
+	Fatal bool
+	// Caller is the call site which added the item. Nil if the report does not capture callers.
+	Caller *Frame
+	// Stack is the call stack which added the item. Nil if the report does not capture stacks.
+	Stack []Frame
 }
 
 // Error implements the error interface.
@@ -43,18 +133,123 @@ func (r ReportError) Unwrap() error {
 	return r.WrappedError
 }
 
+// Attribute returns the typed metadata value for the key. It looks up Attributes first and falls back to Metadata.
+func (r ReportError) Attribute(key string) (Value, bool) {
+	if value, ok := r.Attributes[key]; ok {
+		return value, true
+	}
+
+	if value, ok := r.Metadata[key]; ok {
+		return StringValue(value), true
+	}
+
+	return Value{}, false
+}
+
+// HasFlag returns true if the flag is either the flag or one of the additional flags of the item.
+func (r ReportError) HasFlag(flag ErrorFlag) bool {
+	if r.Flag == flag {
+		return true
+	}
+
+	for _, additionalFlag := range r.Flags {
+		if additionalFlag == flag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllFlags returns the flag and all additional flags of the item.
+func (r ReportError) AllFlags() []ErrorFlag {
+	allFlags := make([]ErrorFlag, 0, len(r.Flags)+1)
+	allFlags = append(allFlags, r.Flag)
+
+	for _, additionalFlag := range r.Flags {
+		if additionalFlag != r.Flag {
+			allFlags = append(allFlags, additionalFlag)
+		}
+	}
+
+	return allFlags
+}
+
+// matchesFlags returns true if the flags of the item match the provided flags according to the match mode.
+func (r ReportError) matchesFlags(match FlagMatch, flags []ErrorFlag) bool {
+	switch match {
+	case FlagMatchAll:
+		for _, flag := range flags {
+			if !r.HasFlag(flag) {
+				return false
+			}
+		}
+
+		return true
+	case FlagMatchNone:
+		for _, flag := range flags {
+			if r.HasFlag(flag) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		for _, flag := range flags {
+			if r.HasFlag(flag) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
 // SimpleReport is a simple implementation for a report.
 type SimpleReport struct {
-	elements   []ReportError
-	fatalError *ReportError
+	// elements is a ring buffer for reports with capacity, head is the index of the oldest item. Use items to get the
+	// items in the order they were added.
+	elements          []ReportError
+	head              int
+	fatalError        *ReportError
+	captureMode       CaptureMode
+	clock             func() time.Time
+	fingerprint       FingerprintFunc
+	fingerprints      map[string]int
+	flagCounts        map[ErrorFlag]int
+	count             int
+	counted           bool
+	capacity          int
+	overflowPolicy    OverflowPolicy
+	dropped           int
+	metadataCollision MetadataCollision
+	summary           SummaryFunc
+	onAdd             []*registeredHook
+	onFatal           []*registeredHook
+	deferHooks        bool
+	pendingHooks      []func()
+	policies          []Policy
+	stopped           bool
+	catalog           *Catalog
+	unknownFlagPolicy UnknownFlagPolicy
+	redactor          *Redactor
 }
 
+// ReportOption configures a report on creation.
+type ReportOption func(*SimpleReport)
+
 // NewSimpleReport creates a new empty error report
-func NewSimpleReport() Report {
-	return &SimpleReport{
+func NewSimpleReport(options ...ReportOption) Report {
+	report := &SimpleReport{
 		elements:   []ReportError{},
 		fatalError: nil,
 	}
+
+	for _, option := range options {
+		option(report)
+	}
+
+	return report
 }
 
 // IsEmpty returns true if the report does not have any item.
@@ -72,12 +267,53 @@ func (s *SimpleReport) HasFatalError() bool {
 	return s.fatalError != nil
 }
 
-// Count returns the number of items in the report.
+// HasErrorsAtLeast returns true if the report does have at least one item with the provided severity or a more
+// serious one. E.g. HasErrorsAtLeast(report, SeverityError) ignores warnings.
+func HasErrorsAtLeast(report Report, severity Severity) bool {
+	found := false
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if element.Severity >= severity {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found
+}
+
+// Count returns the number of errors added to the report. For deduplicating reports this includes all duplicates.
 func (s *SimpleReport) Count() int {
-	return len(s.elements)
+	if s.counted {
+		return s.count
+	}
+
+	return s.sumOccurrences()
+}
+
+// addCount adds the occurrences to the running total of Count. The total is built on the first change, so it has to
+// be called before the items change.
+func (s *SimpleReport) addCount(occurrences int) {
+	if !s.counted {
+		s.count = s.sumOccurrences()
+		s.counted = true
+	}
+
+	s.count += occurrences
 }
 
-// AddError adds an error item into the report. The error item gets a default flag assigned.
+// sumOccurrences returns the sum of the occurrences of all items.
+func (s *SimpleReport) sumOccurrences() int {
+	count := 0
+	for _, element := range s.elements {
+		count += element.occurrences()
+	}
+
+	return count
+}
+
+// AddError adds an error item into the report. The error item gets a default flag and SeverityError assigned.
 func (s *SimpleReport) AddError(err error, metadata ErrorMetadata) {
 	s.AddFlaggedError(err, metadata, ErrorFlagNone)
 }
@@ -89,170 +325,333 @@ func (s *SimpleReport) AddFatalError(err error, metadata ErrorMetadata) {
 	s.AddFlaggedFatalError(err, metadata, ErrorFlagNone)
 }
 
-// AddFlaggedError adds an error with a provided flag to the report.
+// AddFlaggedError adds an error with a provided flag to the report. The error item gets SeverityError assigned.
 func (s *SimpleReport) AddFlaggedError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	s.AddFlaggedWithSeverity(err, metadata, flag, SeverityError)
+}
+
+// AddFlaggedFatalError adds a fatal error with a provided flag to the report. The first added fatal error will be
+// accessible via a special function, every other item will be added normally. The error item gets SeverityFatal
+// assigned.
+func (s *SimpleReport) AddFlaggedFatalError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	s.AddFlaggedWithSeverity(err, metadata, flag, SeverityFatal)
+}
+
+// AddMultiFlaggedError adds an error with multiple flags to the report. The first flag becomes the flag of the error
+// item, the others become additional flags. Without any flag the error item gets a default flag assigned.
+func (s *SimpleReport) AddMultiFlaggedError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	element := newMultiFlaggedElement(err, metadata, flags)
+	element.Severity = SeverityError
+
+	s.add(element)
+}
+
+// AddMultiFlaggedFatalError adds a fatal error with multiple flags to the report. The first flag becomes the flag of
+// the error item, the others become additional flags. Only the first added fatal error will be available via
+// FatalError.
+func (s *SimpleReport) AddMultiFlaggedFatalError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	element := newMultiFlaggedElement(err, metadata, flags)
+	element.Severity = SeverityFatal
+
+	s.add(element)
+}
+
+// newMultiFlaggedElement creates an error item whose flag is the first of the provided flags.
+func newMultiFlaggedElement(err error, metadata ErrorMetadata, flags []ErrorFlag) ReportError {
 	element := ReportError{
 		WrappedError: err,
 		Metadata:     metadata,
-		Flag:         flag,
+		Flag:         ErrorFlagNone,
 	}
 
-	s.elements = append(s.elements, element)
-}
-
-// AddFlaggedFatalError adds a fatal error with a provided flag to the report. The first added fatal error will be
-// accessible via a special function, every other item will be added normally.
-func (s *SimpleReport) AddFlaggedFatalError(err error, metadata ErrorMetadata, flag ErrorFlag) {
-	s.AddFlaggedError(err, metadata, flag)
+	if len(flags) > 0 {
+		element.Flag = flags[0]
+	}
 
-	if s.fatalError != nil {
-		return
+	if len(flags) > 1 {
+		element.Flags = append([]ErrorFlag{}, flags[1:]...)
 	}
 
-	s.fatalError = &ReportError{
+	return element
+}
+
+// AddWithSeverity adds an error item with a provided severity into the report. The error item gets a default flag
+// assigned. An error item with SeverityFatal is added like a fatal error.
+func (s *SimpleReport) AddWithSeverity(err error, metadata ErrorMetadata, severity Severity) {
+	s.AddFlaggedWithSeverity(err, metadata, ErrorFlagNone, severity)
+}
+
+// AddFlaggedWithSeverity adds an error item with a provided flag and severity into the report. An error item with
+// SeverityFatal is added like a fatal error.
+func (s *SimpleReport) AddFlaggedWithSeverity(err error, metadata ErrorMetadata, flag ErrorFlag, severity Severity) {
+	element := ReportError{
 		WrappedError: err,
 		Metadata:     metadata,
 		Flag:         flag,
+		Severity:     severity,
 	}
-}
 
-// AllErrors returns all items as slice.
-func (s *SimpleReport) AllErrors() []ReportError {
-	return s.elements
+	s.add(element)
 }
 
-// FirstError returns the first error in the report. Nil if the report is empty.
-func (s *SimpleReport) FirstError() *ReportError {
-	if len(s.elements) == 0 {
-		return nil
+// AddReportError adds a prepared error item into the report, e.g. to add an item with typed attributes. Time and
+// caller are set by the report if they are empty. An error item with SeverityFatal is added like a fatal error.
+// Reports which are not implemented by this package get the error with its metadata, flag and severity added, all
+// other fields of the item are lost then.
+func AddReportError(report Report, reportErr ReportError) {
+	if adder, ok := report.(interface{ addReportError(reportErr ReportError) }); ok {
+		adder.addReportError(reportErr)
+		return
 	}
 
-	return &s.elements[0]
+	report.AddFlaggedWithSeverity(reportErr.WrappedError, reportErr.Metadata, reportErr.Flag, reportErr.Severity)
 }
 
-// LastError returns the last error of the report. Nil if the report is empty.
-func (s *SimpleReport) LastError() *ReportError {
-	if len(s.elements) == 0 {
-		return nil
+// addReportError adds the prepared error item into the report.
+func (s *SimpleReport) addReportError(reportErr ReportError) {
+	s.add(reportErr)
+}
+
+// add appends the element to the report. Undeclared severities are clamped to SeverityDebug or SeverityFatal. An
+// element with SeverityFatal only becomes the fatal error of the report when there is no fatal error yet.
+func (s *SimpleReport) add(element ReportError) {
+	element.Severity = element.Severity.clamp()
+	element.Fatal = element.Severity == SeverityFatal
+	s.insert(element)
+}
+
+// insert appends the element to the report and runs the hooks. The Fatal field of the element marks it as candidate
+// for the fatal error of the report, it only becomes the fatal error when there is no fatal error yet.
+func (s *SimpleReport) insert(element ReportError) {
+	element, ok := s.applyCatalog(element)
+	if !ok {
+		s.dropped += element.occurrences()
+		return
 	}
 
-	lastIndex := len(s.elements) - 1
-	return &s.elements[lastIndex]
+	hadFatalError := s.HasFatalError()
+	element = s.applyPolicies(element)
+	element = s.store(element)
+	s.runHooks(element, !hadFatalError && s.HasFatalError())
 }
 
-// FilterErrorsByFlag returns only those error items as new report which do have the specific flag.
-func (s *SimpleReport) FilterErrorsByFlag(flag ErrorFlag) Report {
-	filteredReport := &SimpleReport{
-		elements:   make([]ReportError, 0),
-		fatalError: nil,
+// store appends the element to the report unless it is a duplicate or dropped. It returns the element with the
+// fields set by the report.
+func (s *SimpleReport) store(element ReportError) ReportError {
+	if element.Time.IsZero() {
+		element.Time = s.now()
 	}
 
-	if len(s.elements) == 0 {
-		return filteredReport
+	fingerprint, isDuplicate := s.addDuplicate(element)
+	if isDuplicate {
+		element.Fatal = false
+		return element
 	}
 
-	for _, element := range s.elements {
-		if element.Flag == flag {
-			filteredReport.elements = append(filteredReport.elements, element)
+	if element.Caller == nil {
+		if caller, stack := captureFrames(s.captureMode, 0, isInternalFrame); caller != nil {
+			element.Caller = caller
+			if stack != nil {
+				element.Stack = stack
+			}
 		}
 	}
 
-	if s.HasFatalError() && s.fatalError.Flag == flag {
-		filteredReport.fatalError = s.fatalError
+	element.Fatal = element.Fatal && !s.HasFatalError()
+	index := len(s.elements)
+	if s.isFull() {
+		var ok bool
+		if index, ok = s.makeRoom(element); !ok {
+			s.dropped += element.occurrences()
+			element.Fatal = false
+			return element
+		}
 	}
 
-	return filteredReport
-}
-
-// FilterErrorsByFlags returns only those error items as new report which do have one of the specific flags.
-func (s *SimpleReport) FilterErrorsByFlags(flags ...ErrorFlag) Report {
-	filteredReport := &SimpleReport{
-		elements:   make([]ReportError, 0),
-		fatalError: nil,
+	if s.fingerprint != nil {
+		element.Occurrences = element.occurrences()
+		if s.fingerprints != nil {
+			s.fingerprints[fingerprint] = index
+		}
 	}
 
-	if len(s.elements) == 0 {
-		return filteredReport
+	s.addCount(element.occurrences())
+	if index == len(s.elements) {
+		s.elements = append(s.elements, element)
+	} else {
+		s.elements[index] = element
 	}
 
-	for _, element := range s.elements {
-		for _, flag := range flags {
-			if s.HasFatalError() && !filteredReport.HasFatalError() && s.fatalError.Flag == flag {
-				filteredReport.fatalError = s.fatalError
-			}
+	s.countFlags(element, element.occurrences())
 
-			if element.Flag == flag {
-				filteredReport.elements = append(filteredReport.elements, element)
-				break
-			}
-		}
+	if element.Fatal {
+		fatalError := element
+		s.fatalError = &fatalError
 	}
 
-	return filteredReport
+	return element
 }
 
-// ExcludeErrorsByFlag returns all error items as new report which do not have the excluded flag.
-func (s *SimpleReport) ExcludeErrorsByFlag(flag ErrorFlag) Report {
-	filteredReport := &SimpleReport{
-		elements:   make([]ReportError, 0),
-		fatalError: nil,
+// AllErrors returns all items as slice. The items of a report with a redactor are redacted.
+func (s *SimpleReport) AllErrors() []ReportError {
+	return s.exportedItems()
+}
+
+// FirstError returns the first error in the report. Nil if the report is empty.
+func (s *SimpleReport) FirstError() *ReportError {
+	if len(s.elements) == 0 {
+		return nil
 	}
 
+	return s.exportedError(&s.elements[s.head])
+}
+
+// LastError returns the last error of the report. Nil if the report is empty.
+func (s *SimpleReport) LastError() *ReportError {
 	if len(s.elements) == 0 {
-		return filteredReport
+		return nil
 	}
 
-	for _, element := range s.elements {
-		if element.Flag != flag {
+	return s.exportedError(&s.elements[s.index(len(s.elements)-1)])
+}
+
+// newFilteredReport creates a new empty report with the same configuration as this report.
+func (s *SimpleReport) newFilteredReport() *SimpleReport {
+	return &SimpleReport{
+		elements:          make([]ReportError, 0),
+		fatalError:        nil,
+		captureMode:       s.captureMode,
+		clock:             s.clock,
+		fingerprint:       s.fingerprint,
+		capacity:          s.capacity,
+		overflowPolicy:    s.overflowPolicy,
+		metadataCollision: s.metadataCollision,
+		summary:           s.summary,
+		policies:          s.policies,
+		catalog:           s.catalog,
+		unknownFlagPolicy: s.unknownFlagPolicy,
+		redactor:          s.redactor,
+	}
+}
+
+// Filter returns only those error items as new report which match the predicate. The fatal error is carried over to
+// the new report when it matches the predicate as well.
+func (s *SimpleReport) Filter(predicate Predicate) Report {
+	return s.filter(predicate)
+}
+
+// filter returns only those error items as new report which match the predicate.
+func (s *SimpleReport) filter(predicate Predicate) *SimpleReport {
+	filteredReport := s.newFilteredReport()
+
+	for _, element := range s.items() {
+		if predicate(element) {
 			filteredReport.elements = append(filteredReport.elements, element)
 		}
 	}
 
-	if s.HasFatalError() && s.fatalError.Flag != flag {
+	if s.HasFatalError() && predicate(*s.fatalError) {
 		filteredReport.fatalError = s.fatalError
 	}
 
 	return filteredReport
 }
 
+// FilterErrorsByFlag returns only those error items as new report which do have the specific flag.
+func (s *SimpleReport) FilterErrorsByFlag(flag ErrorFlag) Report {
+	return s.FilterErrorsByFlagMatch(FlagMatchAny, flag)
+}
+
+// FilterErrorsByFlags returns only those error items as new report which do have one of the specific flags.
+func (s *SimpleReport) FilterErrorsByFlags(flags ...ErrorFlag) Report {
+	return s.FilterErrorsByFlagMatch(FlagMatchAny, flags...)
+}
+
+// ExcludeErrorsByFlag returns all error items as new report which do not have the excluded flag.
+func (s *SimpleReport) ExcludeErrorsByFlag(flag ErrorFlag) Report {
+	return s.FilterErrorsByFlagMatch(FlagMatchNone, flag)
+}
+
 // ExcludeErrorsByFlags returns all error items as new report which do not have one of the excluded flags.
 func (s *SimpleReport) ExcludeErrorsByFlags(flags ...ErrorFlag) Report {
-	filteredReport := &SimpleReport{
-		elements:   make([]ReportError, 0),
-		fatalError: nil,
-	}
+	return s.FilterErrorsByFlagMatch(FlagMatchNone, flags...)
+}
 
-	if len(s.elements) == 0 {
-		return filteredReport
-	}
+// FilterErrorsByFlagMatch returns only those error items as new report whose flags match the provided flags
+// according to the match mode. The fatal error is carried over to the new report when it matches as well.
+func (s *SimpleReport) FilterErrorsByFlagMatch(match FlagMatch, flags ...ErrorFlag) Report {
+	return s.filter(ByFlagMatch(match, flags...))
+}
 
-	for _, element := range s.elements {
-		shouldAppend := true
-		for _, flag := range flags {
-			if element.Flag == flag {
-				shouldAppend = false
-				break
+// FilterBySeverityAtLeast returns only those error items as new report which do have the provided severity or a more
+// serious one.
+func (s *SimpleReport) FilterBySeverityAtLeast(severity Severity) Report {
+	return s.filter(BySeverityAtLeast(severity))
+}
+
+// HighestSeverity returns the most serious severity of all items of the report. SeverityDebug if the report is empty.
+func HighestSeverity(report Report) Severity {
+	highestSeverity := SeverityDebug
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if element.Severity > highestSeverity {
+				highestSeverity = element.Severity
 			}
 		}
+	})
 
-		if shouldAppend {
-			filteredReport.elements = append(filteredReport.elements, element)
+	return highestSeverity
+}
+
+// FirstSeen returns the time of the earliest added item of the report. Zero time if the report is empty.
+func FirstSeen(report Report) time.Time {
+	var firstSeen time.Time
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if firstSeen.IsZero() || element.Time.Before(firstSeen) {
+				firstSeen = element.Time
+			}
 		}
-	}
+	})
 
-	filteredReport.fatalError = s.fatalError
-	for _, flag := range flags {
-		if filteredReport.HasFatalError() && filteredReport.fatalError.Flag == flag {
-			filteredReport.fatalError = nil
+	return firstSeen
+}
+
+// LastSeen returns the time of the latest added item of the report, including the last time a duplicate was added.
+// Zero time if the report is empty.
+func LastSeen(report Report) time.Time {
+	var lastSeen time.Time
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if element.lastSeen().After(lastSeen) {
+				lastSeen = element.lastSeen()
+			}
 		}
-	}
+	})
 
-	return filteredReport
+	return lastSeen
+}
+
+// FilterByTimeRange returns only those error items as new report which were added in the time range. The range
+// includes from and excludes to. A zero from or to leaves the range open on that side.
+func (s *SimpleReport) FilterByTimeRange(from time.Time, to time.Time) Report {
+	return s.filter(ByTimeRange(from, to))
+}
+
+// ErrorsByTime returns all items of the report as new slice ordered by the time they were added. Items with the same
+// time keep their order.
+func ErrorsByTime(report Report) []ReportError {
+	elements := append([]ReportError{}, report.AllErrors()...)
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].Time.Before(elements[j].Time)
+	})
+
+	return elements
 }
 
 // FatalError returns the first added fatal error. Nil if there does not exist one.
 func (s *SimpleReport) FatalError() *ReportError {
-	return s.fatalError
+	return s.exportedError(s.fatalError)
 }
 
 // ToErrorSlice returns all errors items as an error slice.
@@ -262,14 +661,68 @@ func (s *SimpleReport) ToErrorSlice() []error {
 	}
 
 	var errSlice []error
-	for _, element := range s.elements {
+	for _, element := range s.AllErrors() {
 		errSlice = append(errSlice, element.Unwrap())
 	}
 
 	return errSlice
 }
 
-// Error implements the error interface.
+// Contains returns true if at least one error item of the report matches the target according to errors.Is.
+func Contains(report Report, target error) bool {
+	found := false
+	readReport(report, func(s *SimpleReport) {
+		for _, element := range s.items() {
+			if errors.Is(element, target) {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found
+}
+
+// Unwrap returns all error items of the report, so errors.Is and errors.As do inspect each wrapped error.
+func (s *SimpleReport) Unwrap() []error {
+	errSlice := make([]error, 0, len(s.elements))
+	for _, element := range s.exportedItems() {
+		errSlice = append(errSlice, element)
+	}
+
+	return errSlice
+}
+
+// read calls fn with the report itself.
+func (s *SimpleReport) read(fn func(s *SimpleReport)) {
+	fn(s)
+}
+
+// wrap returns the created report itself.
+func (s *SimpleReport) wrap(report *SimpleReport) Report {
+	return report
+}
+
+// Error implements the error interface. The message is created by the summary function of the report, which is
+// DefaultSummary by default. The summary function gets the redacted copy of a report with a redactor.
 func (s *SimpleReport) Error() string {
-	return fmt.Sprintf("report contains %d error(s)", s.Count())
+	report := s.exported()
+	if s.summary != nil {
+		return s.summary(report)
+	}
+
+	return DefaultSummary(report)
+}
+
+// FindAs returns all error items of the report whose wrapped error matches the type T according to errors.As.
+func FindAs[T error](report Report) []ReportError {
+	matches := make([]ReportError, 0)
+	for _, element := range report.AllErrors() {
+		var target T
+		if errors.As(element.WrappedError, &target) {
+			matches = append(matches, element)
+		}
+	}
+
+	return matches
 }
@@ -1,8 +1,13 @@
 package yeterr
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,6 +52,34 @@ func TestReportError_Unwrap(t *testing.T) {
 	assert.Equal(t, errReadError, reportErr.Unwrap())
 }
 
+func TestReportError_HasFlag(t *testing.T) {
+	reportErr := ReportError{
+		WrappedError: errIOError,
+		Flag:         flagIOError,
+		Flags:        []ErrorFlag{flagReadError},
+	}
+
+	assert.True(t, reportErr.HasFlag(flagIOError))
+	assert.True(t, reportErr.HasFlag(flagReadError))
+	assert.False(t, reportErr.HasFlag(flagWriteError))
+}
+
+func TestReportError_AllFlags(t *testing.T) {
+	t.Run("should return only the flag when there are no additional flags", func(t *testing.T) {
+		assert.Equal(t, []ErrorFlag{flagReadError}, elementRead.AllFlags())
+	})
+
+	t.Run("should return the flag and all additional flags without duplicates", func(t *testing.T) {
+		reportErr := ReportError{
+			WrappedError: errIOError,
+			Flag:         flagIOError,
+			Flags:        []ErrorFlag{flagReadError, flagIOError},
+		}
+
+		assert.Equal(t, []ErrorFlag{flagIOError, flagReadError}, reportErr.AllFlags())
+	})
+}
+
 func TestSimpleReport_IsEmpty_and_HasErrors(t *testing.T) {
 	report := NewSimpleReport()
 
@@ -86,6 +119,17 @@ func TestSimpleReport_Count(t *testing.T) {
 
 		assert.Equal(t, 2, report.Count())
 	})
+
+	t.Run("should keep the count of duplicates and evicted items", func(t *testing.T) {
+		report := NewSimpleReport(WithDeduplication(nil), WithCapacity(2, OverflowDropOldest))
+		report.AddError(errReadError, nil)
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+		assert.Equal(t, 3, report.Count())
+
+		report.AddError(errIOError, nil)
+		assert.Equal(t, 2, report.Count())
+	})
 }
 
 func TestSimpleReport_AddError(t *testing.T) {
@@ -192,6 +236,172 @@ func TestSimpleReport_AddFlaggedFatalError(t *testing.T) {
 	})
 }
 
+func TestSimpleReport_AddMultiFlaggedError(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should add an element with default flag when no flag is provided", func(t *testing.T) {
+		report.AddMultiFlaggedError(errReadError, nil)
+
+		addedElement := report.(*SimpleReport).elements[0]
+		assert.Equal(t, ErrorFlagNone, addedElement.Flag)
+		assert.Nil(t, addedElement.Flags)
+		assert.Equal(t, SeverityError, addedElement.Severity)
+	})
+
+	t.Run("should add an element with multiple flags", func(t *testing.T) {
+		report.AddMultiFlaggedError(errIOError, ErrorMetadata{"filename": "text.txt"}, flagIOError, flagReadError)
+
+		addedElement := report.(*SimpleReport).elements[1]
+		assert.Equal(t, errIOError, addedElement.Unwrap())
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, addedElement.Metadata)
+		assert.Equal(t, flagIOError, addedElement.Flag)
+		assert.Equal(t, []ErrorFlag{flagReadError}, addedElement.Flags)
+		assert.False(t, report.HasFatalError())
+	})
+}
+
+func TestSimpleReport_AddMultiFlaggedFatalError(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should add a fatal error with multiple flags", func(t *testing.T) {
+		report.AddMultiFlaggedFatalError(errIOError, nil, flagIOError, flagWriteError)
+
+		require.True(t, report.HasFatalError())
+		assert.Equal(t, flagIOError, report.FatalError().Flag)
+		assert.Equal(t, []ErrorFlag{flagWriteError}, report.FatalError().Flags)
+		assert.Equal(t, SeverityFatal, report.FatalError().Severity)
+	})
+
+	t.Run("should not overwrite an existing fatal error", func(t *testing.T) {
+		report.AddMultiFlaggedFatalError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, 2, report.Count())
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+	})
+}
+
+func TestSimpleReport_AddReportError(t *testing.T) {
+	report := NewSimpleReport(WithClock(fixedClock(referenceTime)))
+
+	t.Run("should add a prepared element and set its time", func(t *testing.T) {
+		AddReportError(report, ReportError{
+			WrappedError: errReadError,
+			Attributes:   Attributes{"retries": Int64Value(3)},
+			Flag:         flagReadError,
+			Severity:     SeverityWarning,
+		})
+
+		addedElement := report.(*SimpleReport).elements[0]
+		assert.Equal(t, errReadError, addedElement.Unwrap())
+		assert.Equal(t, Attributes{"retries": Int64Value(3)}, addedElement.Attributes)
+		assert.Equal(t, flagReadError, addedElement.Flag)
+		assert.Equal(t, SeverityWarning, addedElement.Severity)
+		assert.Equal(t, referenceTime, addedElement.Time)
+	})
+
+	t.Run("should keep a provided time", func(t *testing.T) {
+		providedTime := referenceTime.Add(-time.Hour)
+		AddReportError(report, ReportError{
+			WrappedError: errWriteError,
+			Time:         providedTime,
+		})
+
+		assert.Equal(t, providedTime, report.LastError().Time)
+	})
+
+	t.Run("should add an element with SeverityFatal as fatal error", func(t *testing.T) {
+		AddReportError(report, ReportError{
+			WrappedError: errIOError,
+			Severity:     SeverityFatal,
+		})
+
+		require.True(t, report.HasFatalError())
+		assert.True(t, report.LastError().Fatal)
+		assert.Equal(t, errIOError, report.FatalError().Unwrap())
+	})
+}
+
+func TestSimpleReport_AddWithSeverity(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should successfully add an element with severity and default flag", func(t *testing.T) {
+		require.True(t, report.IsEmpty())
+
+		report.AddWithSeverity(errReadError, ErrorMetadata{"filename": "text.txt"}, SeverityWarning)
+		assert.Equal(t, 1, report.Count())
+
+		addedElement := report.(*SimpleReport).elements[0]
+		assert.Equal(t, errReadError, addedElement.Unwrap())
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, addedElement.Metadata)
+		assert.Equal(t, ErrorFlagNone, addedElement.Flag)
+		assert.Equal(t, SeverityWarning, addedElement.Severity)
+		assert.False(t, report.HasFatalError())
+	})
+
+	t.Run("should clamp undeclared severities so the report stays marshalable", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddWithSeverity(errReadError, nil, Severity(42))
+		report.AddWithSeverity(errWriteError, nil, Severity(-42))
+
+		elements := report.AllErrors()
+		assert.Equal(t, SeverityFatal, elements[0].Severity)
+		assert.Equal(t, SeverityDebug, elements[1].Severity)
+		assert.True(t, report.HasFatalError())
+
+		_, err := json.Marshal(report)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSimpleReport_AddFlaggedWithSeverity(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should successfully add an element with flag and severity", func(t *testing.T) {
+		require.True(t, report.IsEmpty())
+
+		report.AddFlaggedWithSeverity(errReadError, nil, flagReadError, SeverityInfo)
+		assert.Equal(t, 1, report.Count())
+
+		addedElement := report.(*SimpleReport).elements[0]
+		assert.Equal(t, flagReadError, addedElement.Flag)
+		assert.Equal(t, SeverityInfo, addedElement.Severity)
+		assert.False(t, addedElement.Fatal)
+	})
+
+	t.Run("should add an element with SeverityFatal as fatal error", func(t *testing.T) {
+		report.AddFlaggedWithSeverity(errWriteError, nil, flagWriteError, SeverityFatal)
+
+		addedElement := report.(*SimpleReport).elements[1]
+		assert.True(t, addedElement.Fatal)
+		require.True(t, report.HasFatalError())
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+	})
+
+	t.Run("should not overwrite an existing fatal error", func(t *testing.T) {
+		report.AddFlaggedWithSeverity(errIOError, nil, flagIOError, SeverityFatal)
+
+		addedElement := report.(*SimpleReport).elements[2]
+		assert.Equal(t, SeverityFatal, addedElement.Severity)
+		assert.False(t, addedElement.Fatal)
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+	})
+}
+
+func TestSimpleReport_Severity_of_Add_functions(t *testing.T) {
+	report := NewSimpleReport()
+	report.AddError(errReadError, nil)
+	report.AddFlaggedError(errReadError, nil, flagReadError)
+	report.AddFatalError(errWriteError, nil)
+	report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+	elements := report.AllErrors()
+	assert.Equal(t, SeverityError, elements[0].Severity)
+	assert.Equal(t, SeverityError, elements[1].Severity)
+	assert.Equal(t, SeverityFatal, elements[2].Severity)
+	assert.Equal(t, SeverityFatal, elements[3].Severity)
+	assert.Equal(t, SeverityFatal, report.FatalError().Severity)
+}
+
 func TestSimpleReport_AllErrors(t *testing.T) {
 	report := NewSimpleReport().(*SimpleReport)
 
@@ -289,6 +499,32 @@ func TestSimpleReport_LastError(t *testing.T) {
 	})
 }
 
+func TestSimpleReport_Filter(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	t.Run("should return empty report when report is empty", func(t *testing.T) {
+		filteredReport := report.Filter(ByFlag(flagReadError))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{}}, filteredReport)
+	})
+
+	t.Run("should return only items matching the predicate without fatal error not matching", func(t *testing.T) {
+		report.elements = []ReportError{
+			predicateReadError,
+			predicatePathError,
+		}
+
+		report.fatalError = &predicatePathError
+
+		filteredReport := report.Filter(And(ByFlag(flagIOError), Not(BySeverityAtLeast(SeverityError))))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{predicateReadError}}, filteredReport)
+	})
+
+	t.Run("should carry over the fatal error when it matches the predicate", func(t *testing.T) {
+		filteredReport := report.Filter(ByErrorAs[*os.PathError]())
+		assert.Equal(t, &SimpleReport{elements: []ReportError{predicatePathError}, fatalError: &predicatePathError}, filteredReport)
+	})
+}
+
 func TestSimpleReport_FilterErrorsByFlag(t *testing.T) {
 	report := NewSimpleReport().(*SimpleReport)
 
@@ -596,6 +832,135 @@ func TestSimpleReport_ExcludeErrorsByFlags(t *testing.T) {
 	})
 }
 
+func TestSimpleReport_FilterErrorsByFlagMatch(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	readIOError := ReportError{
+		WrappedError: errIOError,
+		Flag:         flagIOError,
+		Flags:        []ErrorFlag{flagReadError},
+	}
+
+	writeIOError := ReportError{
+		WrappedError: errIOError,
+		Flag:         flagWriteError,
+		Flags:        []ErrorFlag{flagIOError},
+	}
+
+	readError := ReportError{
+		WrappedError: errReadError,
+		Flag:         flagReadError,
+	}
+
+	report.elements = []ReportError{
+		readIOError,
+		writeIOError,
+		readError,
+	}
+
+	report.fatalError = &readIOError
+
+	t.Run("should return items having any of the flags", func(t *testing.T) {
+		filteredReport := report.Filter(ByFlagMatch(FlagMatchAny, flagReadError))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{readIOError, readError}, fatalError: &readIOError}, filteredReport)
+	})
+
+	t.Run("should return items having all of the flags", func(t *testing.T) {
+		filteredReport := report.Filter(ByFlagMatch(FlagMatchAll, flagIOError, flagWriteError))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{writeIOError}}, filteredReport)
+	})
+
+	t.Run("should return items having none of the flags", func(t *testing.T) {
+		filteredReport := report.Filter(ByFlagMatch(FlagMatchNone, flagWriteError))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{readIOError, readError}, fatalError: &readIOError}, filteredReport)
+	})
+
+	t.Run("should match additional flags in single flag filters", func(t *testing.T) {
+		assert.Equal(t, []ReportError{readIOError, writeIOError}, report.FilterErrorsByFlag(flagIOError).AllErrors())
+		assert.Equal(t, []ReportError{readError}, report.ExcludeErrorsByFlag(flagIOError).AllErrors())
+		assert.Equal(t, []ReportError{readError}, report.ExcludeErrorsByFlags(flagIOError, flagWriteError).AllErrors())
+	})
+}
+
+func TestSimpleReport_FilterBySeverityAtLeast(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	t.Run("should return empty report when report is empty", func(t *testing.T) {
+		filteredReport := report.Filter(BySeverityAtLeast(SeverityWarning))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{}}, filteredReport)
+	})
+
+	t.Run("should return only items with at least the provided severity", func(t *testing.T) {
+		warning := ReportError{
+			WrappedError: errReadError,
+			Flag:         flagReadError,
+			Severity:     SeverityWarning,
+		}
+
+		info := ReportError{
+			WrappedError: errWriteError,
+			Flag:         flagWriteError,
+			Severity:     SeverityInfo,
+		}
+
+		critical := ReportError{
+			WrappedError: errIOError,
+			Flag:         flagIOError,
+			Severity:     SeverityCritical,
+		}
+
+		report.elements = []ReportError{
+			warning,
+			info,
+			critical,
+		}
+
+		filteredReport := report.Filter(BySeverityAtLeast(SeverityWarning))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{warning, critical}}, filteredReport)
+	})
+
+	t.Run("should carry over the fatal error", func(t *testing.T) {
+		fatal := ReportError{
+			WrappedError: errIOError,
+			Flag:         flagIOError,
+			Severity:     SeverityFatal,
+			Fatal:        true,
+		}
+
+		report.elements = []ReportError{fatal}
+		report.fatalError = &fatal
+
+		filteredReport := report.Filter(BySeverityAtLeast(SeverityCritical))
+		assert.Equal(t, &SimpleReport{elements: []ReportError{fatal}, fatalError: &fatal}, filteredReport)
+	})
+}
+
+func TestSimpleReport_HighestSeverity_and_HasErrorsAtLeast(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should return SeverityDebug and false when report is empty", func(t *testing.T) {
+		assert.Equal(t, SeverityDebug, HighestSeverity(report))
+		assert.False(t, HasErrorsAtLeast(report, SeverityDebug))
+	})
+
+	t.Run("should ignore warnings when asking for errors", func(t *testing.T) {
+		report.AddWithSeverity(errReadError, nil, SeverityWarning)
+
+		assert.Equal(t, SeverityWarning, HighestSeverity(report))
+		assert.True(t, report.HasErrors())
+		assert.True(t, HasErrorsAtLeast(report, SeverityWarning))
+		assert.False(t, HasErrorsAtLeast(report, SeverityError))
+	})
+
+	t.Run("should return the most serious severity", func(t *testing.T) {
+		report.AddWithSeverity(errWriteError, nil, SeverityCritical)
+		report.AddWithSeverity(errIOError, nil, SeverityInfo)
+
+		assert.Equal(t, SeverityCritical, HighestSeverity(report))
+		assert.True(t, HasErrorsAtLeast(report, SeverityError))
+	})
+}
+
 func TestSimpleReport_FatalError(t *testing.T) {
 	report := NewSimpleReport().(*SimpleReport)
 
@@ -647,6 +1012,78 @@ func TestSimpleReport_ToErrorSlice(t *testing.T) {
 	})
 }
 
+func TestSimpleReport_Contains(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should return false when report is empty", func(t *testing.T) {
+		assert.False(t, Contains(report, errReadError))
+	})
+
+	t.Run("should return true when an item matches the target", func(t *testing.T) {
+		report.AddError(errReadError, nil)
+		report.AddError(fmt.Errorf("wrapped: %w", io.EOF), nil)
+
+		assert.True(t, Contains(report, errReadError))
+		assert.True(t, Contains(report, io.EOF))
+	})
+
+	t.Run("should return false when no item matches the target", func(t *testing.T) {
+		assert.False(t, Contains(report, errWriteError))
+	})
+}
+
+func TestSimpleReport_Unwrap(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+
+	t.Run("should return an empty slice when report is empty", func(t *testing.T) {
+		assert.Equal(t, []error{}, report.Unwrap())
+		assert.False(t, errors.Is(report, errReadError))
+	})
+
+	t.Run("should make errors.Is inspect every item", func(t *testing.T) {
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedError(fmt.Errorf("wrapped: %w", io.EOF), nil, flagIOError)
+
+		assert.Len(t, report.Unwrap(), 2)
+		assert.True(t, errors.Is(report, errReadError))
+		assert.True(t, errors.Is(report, io.EOF))
+		assert.False(t, errors.Is(report, errWriteError))
+	})
+
+	t.Run("should make errors.As inspect every item", func(t *testing.T) {
+		report.AddError(&os.PathError{Op: "open", Path: "text.txt", Err: os.ErrNotExist}, nil)
+
+		var pathErr *os.PathError
+		require.True(t, errors.As(report, &pathErr))
+		assert.Equal(t, "text.txt", pathErr.Path)
+
+		var reportErr ReportError
+		require.True(t, errors.As(report, &reportErr))
+		assert.Equal(t, flagReadError, reportErr.Flag)
+	})
+}
+
+func TestFindAs(t *testing.T) {
+	report := NewSimpleReport()
+
+	t.Run("should return an empty slice when there are no matches", func(t *testing.T) {
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+
+		assert.Equal(t, []ReportError{}, FindAs[*os.PathError](report))
+	})
+
+	t.Run("should return all matching items including flag and metadata", func(t *testing.T) {
+		pathErr := &os.PathError{Op: "open", Path: "text.txt", Err: os.ErrNotExist}
+		report.AddFlaggedError(fmt.Errorf("wrapped: %w", pathErr), ErrorMetadata{"filename": "text.txt"}, flagIOError)
+		report.AddFlaggedError(errWriteError, nil, flagWriteError)
+
+		matches := FindAs[*os.PathError](report)
+		require.Len(t, matches, 1)
+		assert.Equal(t, flagIOError, matches[0].Flag)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, matches[0].Metadata)
+	})
+}
+
 func TestSimpleReport_Error(t *testing.T) {
 	report := SimpleReport{
 		elements: []ReportError{
@@ -657,3 +1094,59 @@ func TestSimpleReport_Error(t *testing.T) {
 
 	assert.Equal(t, "report contains 2 error(s)", report.Error())
 }
+
+// foreignReport is a report implementation outside of this package, which only has the methods of Report.
+type foreignReport struct {
+	Report
+}
+
+func TestPackageFunctions_foreignReport(t *testing.T) {
+	t.Run("should add prepared items with flag and severity", func(t *testing.T) {
+		report := foreignReport{NewSimpleReport()}
+		AddReportError(report, ReportError{
+			WrappedError: errReadError,
+			Flag:         flagReadError,
+			Severity:     SeverityWarning,
+			Attributes:   Attributes{"retries": IntValue(3)},
+		})
+
+		require.Equal(t, 1, report.Count())
+		assert.Equal(t, flagReadError, report.FirstError().Flag)
+		assert.Equal(t, SeverityWarning, report.FirstError().Severity)
+		assert.Nil(t, report.FirstError().Attributes)
+	})
+
+	t.Run("should derive queries from the items", func(t *testing.T) {
+		report := foreignReport{NewSimpleReport()}
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedWithSeverity(errWriteError, nil, flagWriteError, SeverityCritical)
+
+		assert.Equal(t, 2, UniqueCount(report))
+		assert.Equal(t, 0, Dropped(report))
+		assert.Equal(t, SeverityCritical, HighestSeverity(report))
+		assert.True(t, HasErrorsAtLeast(report, SeverityCritical))
+		assert.True(t, Contains(report, errWriteError))
+		assert.Len(t, GroupByFlag(report), 2)
+		assert.Len(t, ErrorsByTime(report), 2)
+		assert.Equal(t, report, Redacted(report))
+	})
+
+	t.Run("should add and check", func(t *testing.T) {
+		report := foreignReport{NewSimpleReport()}
+		assert.NoError(t, AddAndCheck(report, errReadError, nil))
+
+		report.AddFatalError(errWriteError, nil)
+		assert.Equal(t, ErrShouldStop, AddAndCheck(report, errReadError, nil))
+	})
+}
+
+func TestSimpleReport_FilterMethods(t *testing.T) {
+	report := NewSimpleReport().(*SimpleReport)
+	report.AddMultiFlaggedError(errIOError, nil, flagIOError, flagWriteError)
+	report.AddWithSeverity(errReadError, nil, SeverityWarning)
+
+	assert.Equal(t, 1, report.FilterErrorsByFlagMatch(FlagMatchAll, flagIOError, flagWriteError).Count())
+	assert.Equal(t, 1, report.FilterBySeverityAtLeast(SeverityError).Count())
+	assert.Equal(t, 2, report.FilterByTimeRange(time.Time{}, time.Time{}).Count())
+	assert.Equal(t, SeverityError, HighestSeverity(report))
+}
@@ -0,0 +1,420 @@
+package yeterr
+
+import (
+	"strings"
+	"time"
+)
+
+// scopeSeparator separates the names of nested child reports in a scope path.
+const scopeSeparator = "/"
+
+// MetadataCollision defines which value is kept when the default metadata of a scoped report and the metadata of an
+// added error item have the same key.
+type MetadataCollision int
+
+const (
+	// MetadataEntryWins keeps the value of the added error item. This is the default.
+	MetadataEntryWins MetadataCollision = iota
+	// MetadataScopeWins keeps the value of the default metadata.
+	MetadataScopeWins
+)
+
+// WithMetadataCollision sets the collision rule for the default metadata of scoped reports created by WithMetadata.
+func WithMetadataCollision(collision MetadataCollision) ReportOption {
+	return func(s *SimpleReport) {
+		s.metadataCollision = collision
+	}
+}
+
+// scopedReport is a view of a report. It records all error items into its parent with its scope path and default
+// metadata and only reads those items of the parent which are in its scope or a nested scope.
+type scopedReport struct {
+	parent    Report
+	scope     string
+	metadata  ErrorMetadata
+	collision MetadataCollision
+}
+
+// Child returns a child report which records all error items into this report. The items get the name of the child
+// as scope. Children of the child extend the scope path, e.g. "import/users/row-12".
+func (s *SimpleReport) Child(name string) Report {
+	return &scopedReport{
+		parent:    s,
+		scope:     name,
+		collision: s.metadataCollision,
+	}
+}
+
+// Child returns a child report which records all error items into this report. The items get the name of the child
+// as scope.
+func (c *ConcurrentReport) Child(name string) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &scopedReport{
+		parent:    c,
+		scope:     name,
+		collision: c.simple().metadataCollision,
+	}
+}
+
+// WithMetadata returns a scoped report which records all error items into this report and merges the default
+// metadata into the metadata of every added item. Reading the scoped report reads this report.
+func (s *SimpleReport) WithMetadata(metadata ErrorMetadata) Report {
+	return &scopedReport{
+		parent:    s,
+		metadata:  metadata,
+		collision: s.metadataCollision,
+	}
+}
+
+// WithMetadata returns a scoped report which records all error items into this report and merges the default
+// metadata into the metadata of every added item.
+func (c *ConcurrentReport) WithMetadata(metadata ErrorMetadata) Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &scopedReport{
+		parent:    c,
+		metadata:  metadata,
+		collision: c.simple().metadataCollision,
+	}
+}
+
+// ByScope returns a predicate matching error items which were added in the scope or in a nested scope. An empty scope
+// matches all error items.
+func ByScope(scope string) Predicate {
+	return func(reportErr ReportError) bool {
+		return scope == "" || reportErr.Scope == scope || strings.HasPrefix(reportErr.Scope, scope+scopeSeparator)
+	}
+}
+
+// joinScope appends the name to the scope path.
+func joinScope(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+
+	if name == "" {
+		return scope
+	}
+
+	return scope + scopeSeparator + name
+}
+
+// view returns the items of the parent which are in the scope of the view. The parent is a report of this package,
+// so the view has all methods of the reports of this package.
+func (c *scopedReport) view() extendedReport {
+	return c.parent.Filter(ByScope(c.scope)).(extendedReport)
+}
+
+// add records the element into the parent with the scope and default metadata of the view.
+func (c *scopedReport) add(element ReportError) {
+	AddReportError(c.parent, c.decorate(element))
+}
+
+// decorate nests the scope of the element into the scope of the view and merges the default metadata into the
+// metadata of the element according to the collision rule.
+func (c *scopedReport) decorate(element ReportError) ReportError {
+	element.Scope = joinScope(c.scope, element.Scope)
+	if len(c.metadata) == 0 {
+		return element
+	}
+
+	metadata := make(ErrorMetadata, len(c.metadata)+len(element.Metadata))
+	if c.collision == MetadataScopeWins {
+		mergeMetadata(metadata, element.Metadata, c.metadata)
+	} else {
+		mergeMetadata(metadata, c.metadata, element.Metadata)
+	}
+
+	element.Metadata = metadata
+	return element
+}
+
+// mergeMetadata copies all sources into the target. Later sources overwrite the keys of earlier ones.
+func mergeMetadata(target ErrorMetadata, sources ...ErrorMetadata) {
+	for _, source := range sources {
+		for key, value := range source {
+			target[key] = value
+		}
+	}
+}
+
+// IsEmpty returns true if the scope of the view does not have any item.
+func (c *scopedReport) IsEmpty() bool {
+	return c.view().IsEmpty()
+}
+
+// HasErrors returns true if the scope of the view does have at least one item.
+func (c *scopedReport) HasErrors() bool {
+	return c.view().HasErrors()
+}
+
+// HasFatalError returns true if the fatal error of the parent is in the scope of the view.
+func (c *scopedReport) HasFatalError() bool {
+	return c.view().HasFatalError()
+}
+
+// Count returns the number of errors added in the scope of the view.
+func (c *scopedReport) Count() int {
+	return c.view().Count()
+}
+
+// AddError adds an error item into the parent. The error item gets a default flag and SeverityError assigned.
+func (c *scopedReport) AddError(err error, metadata ErrorMetadata) {
+	c.AddFlaggedError(err, metadata, ErrorFlagNone)
+}
+
+// AddFatalError adds a fatal error into the parent. It only becomes the fatal error of the parent when the parent
+// does not have a fatal error yet.
+func (c *scopedReport) AddFatalError(err error, metadata ErrorMetadata) {
+	c.AddFlaggedFatalError(err, metadata, ErrorFlagNone)
+}
+
+// AddFlaggedError adds an error with a provided flag into the parent. The error item gets SeverityError assigned.
+func (c *scopedReport) AddFlaggedError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	c.AddFlaggedWithSeverity(err, metadata, flag, SeverityError)
+}
+
+// AddFlaggedFatalError adds a fatal error with a provided flag into the parent.
+func (c *scopedReport) AddFlaggedFatalError(err error, metadata ErrorMetadata, flag ErrorFlag) {
+	c.AddFlaggedWithSeverity(err, metadata, flag, SeverityFatal)
+}
+
+// AddMultiFlaggedError adds an error with multiple flags into the parent.
+func (c *scopedReport) AddMultiFlaggedError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	element := newMultiFlaggedElement(err, metadata, flags)
+	element.Severity = SeverityError
+
+	c.add(element)
+}
+
+// AddMultiFlaggedFatalError adds a fatal error with multiple flags into the parent.
+func (c *scopedReport) AddMultiFlaggedFatalError(err error, metadata ErrorMetadata, flags ...ErrorFlag) {
+	element := newMultiFlaggedElement(err, metadata, flags)
+	element.Severity = SeverityFatal
+
+	c.add(element)
+}
+
+// AddWithSeverity adds an error item with a provided severity into the parent.
+func (c *scopedReport) AddWithSeverity(err error, metadata ErrorMetadata, severity Severity) {
+	c.AddFlaggedWithSeverity(err, metadata, ErrorFlagNone, severity)
+}
+
+// AddFlaggedWithSeverity adds an error item with a provided flag and severity into the parent.
+func (c *scopedReport) AddFlaggedWithSeverity(err error, metadata ErrorMetadata, flag ErrorFlag, severity Severity) {
+	c.add(ReportError{
+		WrappedError: err,
+		Metadata:     metadata,
+		Flag:         flag,
+		Severity:     severity,
+	})
+}
+
+// addReportError adds the prepared error item into the parent. A scope of the item is nested into the scope of the
+// view.
+func (c *scopedReport) addReportError(reportErr ReportError) {
+	c.add(reportErr)
+}
+
+// Merge adds all error items of the other report into the parent. The scopes of the items are nested into the scope
+// of the view and the default metadata is merged into their metadata. The fatal error of the parent takes precedence
+// over the fatal error of the other report.
+func (c *scopedReport) Merge(other Report) {
+	decoratedReport := &SimpleReport{
+		elements: make([]ReportError, 0),
+		dropped:  Dropped(other),
+	}
+
+	for _, element := range other.AllErrors() {
+		decoratedReport.elements = append(decoratedReport.elements, c.decorate(element))
+	}
+
+	c.parent.Merge(decoratedReport)
+}
+
+// Child returns a nested child report which records all error items into the same parent. It keeps the default
+// metadata of the view.
+func (c *scopedReport) Child(name string) Report {
+	return &scopedReport{
+		parent:    c.parent,
+		scope:     joinScope(c.scope, name),
+		metadata:  c.metadata,
+		collision: c.collision,
+	}
+}
+
+// WithMetadata returns a nested scoped report which records all error items into the same parent. The default
+// metadata is merged into the default metadata of the view, the nested values win.
+func (c *scopedReport) WithMetadata(metadata ErrorMetadata) Report {
+	nestedMetadata := make(ErrorMetadata, len(c.metadata)+len(metadata))
+	mergeMetadata(nestedMetadata, c.metadata, metadata)
+
+	return &scopedReport{
+		parent:    c.parent,
+		scope:     c.scope,
+		metadata:  nestedMetadata,
+		collision: c.collision,
+	}
+}
+
+// AllErrors returns all items in the scope of the view as slice.
+func (c *scopedReport) AllErrors() []ReportError {
+	return c.view().AllErrors()
+}
+
+// FirstError returns the first error in the scope of the view. Nil if there is none.
+func (c *scopedReport) FirstError() *ReportError {
+	return c.view().FirstError()
+}
+
+// LastError returns the last error in the scope of the view. Nil if there is none.
+func (c *scopedReport) LastError() *ReportError {
+	return c.view().LastError()
+}
+
+// Filter returns only those error items in the scope of the view as new report which match the predicate.
+func (c *scopedReport) Filter(predicate Predicate) Report {
+	return c.view().Filter(predicate)
+}
+
+// FilterErrorsByFlag returns only those error items in the scope of the view as new report which do have the
+// specific flag.
+func (c *scopedReport) FilterErrorsByFlag(flag ErrorFlag) Report {
+	return c.view().FilterErrorsByFlag(flag)
+}
+
+// FilterErrorsByFlags returns only those error items in the scope of the view as new report which do have one of
+// the specific flags.
+func (c *scopedReport) FilterErrorsByFlags(flags ...ErrorFlag) Report {
+	return c.view().FilterErrorsByFlags(flags...)
+}
+
+// ExcludeErrorsByFlag returns all error items in the scope of the view as new report which do not have the
+// excluded flag.
+func (c *scopedReport) ExcludeErrorsByFlag(flag ErrorFlag) Report {
+	return c.view().ExcludeErrorsByFlag(flag)
+}
+
+// ExcludeErrorsByFlags returns all error items in the scope of the view as new report which do not have one of the
+// excluded flags.
+func (c *scopedReport) ExcludeErrorsByFlags(flags ...ErrorFlag) Report {
+	return c.view().ExcludeErrorsByFlags(flags...)
+}
+
+// FilterErrorsByFlagMatch returns only those error items in the scope of the view as new report whose flags match
+// the provided flags according to the match mode.
+func (c *scopedReport) FilterErrorsByFlagMatch(match FlagMatch, flags ...ErrorFlag) Report {
+	return c.view().FilterErrorsByFlagMatch(match, flags...)
+}
+
+// FilterBySeverityAtLeast returns only those error items in the scope of the view as new report which do have the
+// provided severity or a more serious one.
+func (c *scopedReport) FilterBySeverityAtLeast(severity Severity) Report {
+	return c.view().FilterBySeverityAtLeast(severity)
+}
+
+// FilterByTimeRange returns only those error items in the scope of the view as new report which were added in the
+// time range.
+func (c *scopedReport) FilterByTimeRange(from time.Time, to time.Time) Report {
+	return c.view().FilterByTimeRange(from, to)
+}
+
+// FatalError returns the fatal error of the parent if it is in the scope of the view. Nil otherwise.
+func (c *scopedReport) FatalError() *ReportError {
+	return c.view().FatalError()
+}
+
+// ToErrorSlice returns all error items in the scope of the view as an error slice.
+func (c *scopedReport) ToErrorSlice() []error {
+	return c.view().ToErrorSlice()
+}
+
+// Unwrap returns all error items in the scope of the view.
+func (c *scopedReport) Unwrap() []error {
+	return c.view().Unwrap()
+}
+
+// Error implements the error interface.
+func (c *scopedReport) Error() string {
+	return c.view().Error()
+}
+
+// read calls fn with the items of the parent which are in the scope of the view.
+func (c *scopedReport) read(fn func(s *SimpleReport)) {
+	readReport(c.view(), fn)
+}
+
+// wrap returns the created report as report of the same kind as the parent.
+func (c *scopedReport) wrap(report *SimpleReport) Report {
+	return wrapReport(c.parent, report)
+}
+
+// ScopeNode is a node of the scope tree of a report.
+type ScopeNode struct {
+	// Name is the name of the child report of the node. Empty for the root node.
+	Name string
+	// Scope is the full scope path of the node. Empty for the root node.
+	Scope string
+	// Errors are the error items which were added exactly in the scope of the node.
+	Errors []ReportError
+	// Children are the nested scopes ordered by their first occurrence.
+	Children []*ScopeNode
+}
+
+// ScopeTree arranges the error items of the report as a tree of their scopes. The root node contains the items which
+// were added to the report itself.
+func ScopeTree(report Report) *ScopeNode {
+	root := &ScopeNode{}
+	for _, element := range report.AllErrors() {
+		node := root
+		if element.Scope != "" {
+			for _, name := range strings.Split(element.Scope, scopeSeparator) {
+				node = node.child(name)
+			}
+		}
+
+		node.Errors = append(node.Errors, element)
+	}
+
+	return root
+}
+
+// child returns the child node with the name and creates it if it does not exist yet.
+func (n *ScopeNode) child(name string) *ScopeNode {
+	for _, child := range n.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+
+	child := &ScopeNode{
+		Name:  name,
+		Scope: joinScope(n.Scope, name),
+	}
+
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// String renders the tree with one line per scope and error item. Nested lines are indented by two spaces.
+func (n *ScopeNode) String() string {
+	var builder strings.Builder
+	n.write(&builder, "")
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// write renders the error items and children of the node with the indentation.
+func (n *ScopeNode) write(builder *strings.Builder, indent string) {
+	for _, element := range n.Errors {
+		builder.WriteString(indent + "- " + element.Message() + "\n")
+	}
+
+	for _, child := range n.Children {
+		builder.WriteString(indent + child.Name + "\n")
+		child.write(builder, indent+"  ")
+	}
+}
@@ -0,0 +1,181 @@
+package yeterr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleReport_Child(t *testing.T) {
+	t.Run("should record items into the parent with the scope", func(t *testing.T) {
+		report := NewSimpleReport()
+		child := report.Child("import")
+		child.AddFlaggedError(errReadError, ErrorMetadata{"filename": "text.txt"}, flagReadError)
+
+		require.Equal(t, 1, report.Count())
+		assert.Equal(t, "import", report.FirstError().Scope)
+		assert.Equal(t, flagReadError, report.FirstError().Flag)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, report.FirstError().Metadata)
+	})
+
+	t.Run("should extend the scope path for nested children", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.Child("import").Child("users").Child("row-12").AddError(errReadError, nil)
+		AddReportError(report.Child("import"), ReportError{WrappedError: errWriteError, Scope: "groups"})
+
+		assert.Equal(t, "import/users/row-12", report.FirstError().Scope)
+		assert.Equal(t, "import/groups", report.LastError().Scope)
+	})
+
+	t.Run("should only read the items in its scope", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddError(errIOError, nil)
+		importChild := report.Child("import")
+		importChild.Child("users").AddError(errReadError, nil)
+		report.Child("importer").AddError(errWriteError, nil)
+
+		assert.Equal(t, 1, importChild.Count())
+		assert.Equal(t, errReadError, importChild.FirstError().Unwrap())
+		assert.True(t, Contains(importChild, errReadError))
+		assert.False(t, Contains(importChild, errWriteError))
+		assert.Equal(t, "report contains 1 error(s)", importChild.Error())
+		assert.Equal(t, 3, report.Count())
+	})
+
+	t.Run("should add fatal errors to the parent", func(t *testing.T) {
+		report := NewSimpleReport()
+		importChild := report.Child("import")
+		exportChild := report.Child("export")
+		exportChild.AddFatalError(errWriteError, nil)
+		importChild.AddFatalError(errReadError, nil)
+
+		assert.Equal(t, errWriteError, report.FatalError().Unwrap())
+		assert.True(t, exportChild.HasFatalError())
+		assert.False(t, importChild.HasFatalError())
+		assert.Nil(t, importChild.FatalError())
+	})
+
+	t.Run("should merge other reports into its scope", func(t *testing.T) {
+		other := NewSimpleReport()
+		other.AddFatalError(errReadError, nil)
+		other.Child("row-1").AddError(errWriteError, nil)
+
+		report := NewSimpleReport()
+		report.Child("import").Merge(other)
+
+		assert.Equal(t, []string{"import", "import/row-1"}, []string{report.FirstError().Scope, report.LastError().Scope})
+		assert.Equal(t, errReadError, report.FatalError().Unwrap())
+	})
+
+	t.Run("should be usable with a concurrent report", func(t *testing.T) {
+		report := NewConcurrentReport()
+		report.Child("import").AddError(errReadError, nil)
+
+		assert.Equal(t, "import", report.FirstError().Scope)
+		assert.Equal(t, 1, report.Child("import").Count())
+	})
+}
+
+func TestByScope(t *testing.T) {
+	assert.True(t, ByScope("")(ReportError{}))
+	assert.True(t, ByScope("import")(ReportError{Scope: "import"}))
+	assert.True(t, ByScope("import")(ReportError{Scope: "import/users"}))
+	assert.False(t, ByScope("import")(ReportError{Scope: "importer"}))
+	assert.False(t, ByScope("import/users")(ReportError{Scope: "import"}))
+}
+
+func TestScopeTree(t *testing.T) {
+	report := NewSimpleReport()
+	report.AddError(errIOError, nil)
+	importChild := report.Child("import")
+	importChild.Child("users").Child("row-12").AddError(errReadError, nil)
+	importChild.AddError(errWriteError, nil)
+
+	tree := ScopeTree(report)
+	require.Len(t, tree.Children, 1)
+	assert.Equal(t, "import", tree.Children[0].Name)
+	assert.Equal(t, "import/users/row-12", tree.Children[0].Children[0].Children[0].Scope)
+	assert.Equal(t, "- this simulates an IO error\n"+
+		"import\n"+
+		"  - this simulates a write error\n"+
+		"  users\n"+
+		"    row-12\n"+
+		"      - this simulates a read error", tree.String())
+}
+
+func TestScopeTree_NilError(t *testing.T) {
+	report := NewSimpleReport()
+	report.Child("import").AddError(nil, nil)
+
+	assert.Equal(t, "import\n  - <nil>", ScopeTree(report).String())
+}
+
+func TestSimpleReport_WithMetadata(t *testing.T) {
+	t.Run("should merge the default metadata into added items", func(t *testing.T) {
+		report := NewSimpleReport()
+		jobReport := report.WithMetadata(ErrorMetadata{"job_id": "42", "tenant": "acme"})
+		jobReport.AddError(errReadError, ErrorMetadata{"filename": "text.txt"})
+		jobReport.AddFatalError(errWriteError, nil)
+
+		require.Equal(t, 2, report.Count())
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "tenant": "acme", "filename": "text.txt"}, report.FirstError().Metadata)
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "tenant": "acme"}, report.FatalError().Metadata)
+		assert.Equal(t, 2, jobReport.Count())
+	})
+
+	t.Run("should not change the metadata of the caller", func(t *testing.T) {
+		defaults := ErrorMetadata{"job_id": "42"}
+		metadata := ErrorMetadata{"filename": "text.txt"}
+
+		report := NewSimpleReport()
+		report.WithMetadata(defaults).AddError(errReadError, metadata)
+
+		assert.Equal(t, ErrorMetadata{"job_id": "42"}, defaults)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, metadata)
+	})
+
+	t.Run("should let the entry win by default", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.WithMetadata(ErrorMetadata{"tenant": "acme"}).AddError(errReadError, ErrorMetadata{"tenant": "other"})
+
+		assert.Equal(t, ErrorMetadata{"tenant": "other"}, report.FirstError().Metadata)
+	})
+
+	t.Run("should let the scope win when configured", func(t *testing.T) {
+		report := NewSimpleReport(WithMetadataCollision(MetadataScopeWins))
+		report.WithMetadata(ErrorMetadata{"tenant": "acme"}).AddError(errReadError, ErrorMetadata{"tenant": "other"})
+
+		assert.Equal(t, ErrorMetadata{"tenant": "acme"}, report.FirstError().Metadata)
+	})
+
+	t.Run("should nest scopes", func(t *testing.T) {
+		report := NewSimpleReport()
+		jobReport := report.WithMetadata(ErrorMetadata{"job_id": "42", "step": "init"})
+		jobReport.WithMetadata(ErrorMetadata{"step": "import"}).Child("users").AddError(errReadError, nil)
+		jobReport.Child("users").WithMetadata(ErrorMetadata{"row": "12"}).AddError(errWriteError, nil)
+
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "step": "import"}, report.FirstError().Metadata)
+		assert.Equal(t, "users", report.FirstError().Scope)
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "step": "init", "row": "12"}, report.LastError().Metadata)
+		assert.Equal(t, "users", report.LastError().Scope)
+	})
+
+	t.Run("should merge the default metadata into merged reports", func(t *testing.T) {
+		other := NewSimpleReport()
+		other.AddError(errReadError, ErrorMetadata{"filename": "text.txt"})
+
+		report := NewSimpleReport()
+		report.WithMetadata(ErrorMetadata{"job_id": "42"}).Merge(other)
+
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "filename": "text.txt"}, report.FirstError().Metadata)
+		assert.Equal(t, ErrorMetadata{"filename": "text.txt"}, other.FirstError().Metadata)
+	})
+
+	t.Run("should be usable with a concurrent report", func(t *testing.T) {
+		report := NewConcurrentReport(WithMetadataCollision(MetadataScopeWins))
+		report.WithMetadata(ErrorMetadata{"tenant": "acme"}).AddError(errReadError, ErrorMetadata{"tenant": "other"})
+
+		assert.Equal(t, ErrorMetadata{"tenant": "acme"}, report.FirstError().Metadata)
+	})
+}
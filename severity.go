@@ -0,0 +1,81 @@
+package yeterr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity describes how serious an error item is. Severities are ordered, a higher value means a more serious
+// error. The zero value is SeverityError, so error items without an explicit severity are treated as errors.
+type Severity int
+
+const (
+	SeverityDebug Severity = iota - 3
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+	SeverityFatal
+)
+
+var severityNames = map[Severity]string{
+	SeverityDebug:    "debug",
+	SeverityInfo:     "info",
+	SeverityWarning:  "warning",
+	SeverityError:    "error",
+	SeverityCritical: "critical",
+	SeverityFatal:    "fatal",
+}
+
+// ParseSeverity returns the severity for its name. The name is matched case-insensitively.
+func ParseSeverity(name string) (Severity, error) {
+	for severity, severityName := range severityNames {
+		if strings.EqualFold(name, severityName) {
+			return severity, nil
+		}
+	}
+
+	return SeverityError, fmt.Errorf("yeterr: unknown severity %q", name)
+}
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// clamp returns the nearest declared severity for values outside of the range from SeverityDebug to SeverityFatal.
+func (s Severity) clamp() Severity {
+	switch {
+	case s < SeverityDebug:
+		return SeverityDebug
+	case s > SeverityFatal:
+		return SeverityFatal
+	default:
+		return s
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. It returns an error for undeclared severities, reports
+// clamp them when error items are added.
+func (s Severity) MarshalText() ([]byte, error) {
+	if _, ok := severityNames[s]; !ok {
+		return nil, fmt.Errorf("yeterr: unknown severity %d", int(s))
+	}
+
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Severity) UnmarshalText(text []byte) error {
+	severity, err := ParseSeverity(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = severity
+	return nil
+}
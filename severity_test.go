@@ -0,0 +1,64 @@
+package yeterr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity_String(t *testing.T) {
+	t.Run("should return the name of a known severity", func(t *testing.T) {
+		assert.Equal(t, "debug", SeverityDebug.String())
+		assert.Equal(t, "warning", SeverityWarning.String())
+		assert.Equal(t, "fatal", SeverityFatal.String())
+	})
+
+	t.Run("should return the numeric value of an unknown severity", func(t *testing.T) {
+		assert.Equal(t, "Severity(42)", Severity(42).String())
+	})
+}
+
+func TestSeverity_Order(t *testing.T) {
+	t.Run("should use SeverityError as zero value", func(t *testing.T) {
+		var severity Severity
+		assert.Equal(t, SeverityError, severity)
+	})
+
+	t.Run("should order severities from debug to fatal", func(t *testing.T) {
+		ordered := []Severity{SeverityDebug, SeverityInfo, SeverityWarning, SeverityError, SeverityCritical, SeverityFatal}
+		for i := 1; i < len(ordered); i++ {
+			assert.Less(t, int(ordered[i-1]), int(ordered[i]))
+		}
+	})
+}
+
+func TestParseSeverity(t *testing.T) {
+	t.Run("should parse a severity case-insensitively", func(t *testing.T) {
+		severity, err := ParseSeverity("Warning")
+		require.NoError(t, err)
+		assert.Equal(t, SeverityWarning, severity)
+	})
+
+	t.Run("should return an error for an unknown severity", func(t *testing.T) {
+		_, err := ParseSeverity("panic")
+		assert.Error(t, err)
+	})
+}
+
+func TestSeverity_MarshalText(t *testing.T) {
+	t.Run("should marshal and unmarshal a severity", func(t *testing.T) {
+		text, err := SeverityCritical.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "critical", string(text))
+
+		var severity Severity
+		require.NoError(t, severity.UnmarshalText(text))
+		assert.Equal(t, SeverityCritical, severity)
+	})
+
+	t.Run("should return an error for an unknown severity", func(t *testing.T) {
+		_, err := Severity(42).MarshalText()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,383 @@
+package yeterr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"sort"
+	"strconv"
+)
+
+// LogValue implements the slog.LogValuer interface. The item is logged as group with its message, flags, severity,
+// metadata and attributes as nested groups and the fatal marker.
+func (r ReportError) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("message", r.Message()),
+		slog.String("flag", r.Flag.String()),
+	}
+
+	if len(r.Flags) > 0 {
+		flags := make([]string, 0, len(r.Flags))
+		for _, flag := range r.Flags {
+			flags = append(flags, flag.String())
+		}
+
+		attrs = append(attrs, slog.Any("flags", flags))
+	}
+
+	attrs = append(attrs, slog.String("severity", r.Severity.String()))
+
+	if r.MessageKey != "" {
+		attrs = append(attrs, slog.String("message_key", r.MessageKey))
+	}
+
+	if r.Scope != "" {
+		attrs = append(attrs, slog.String("scope", r.Scope))
+	}
+
+	if len(r.Metadata) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "metadata", Value: metadataLogValue(r.Metadata)})
+	}
+
+	if len(r.Attributes) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "attributes", Value: attributesLogValue(r.Attributes)})
+	}
+
+	if r.Occurrences > 1 {
+		attrs = append(attrs, slog.Int("occurrences", r.Occurrences))
+	}
+
+	if r.Fatal {
+		attrs = append(attrs, slog.Bool("fatal", true))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements the slog.LogValuer interface. The report is logged as group with the number of errors, the
+// dropped errors, the fatal error and all items in a group keyed by their index. A report with a redactor is logged
+// redacted.
+func (s *SimpleReport) LogValue() slog.Value {
+	s = s.exported()
+	attrs := []slog.Attr{
+		slog.Int("count", s.Count()),
+	}
+
+	if s.dropped > 0 {
+		attrs = append(attrs, slog.Int("dropped", s.dropped))
+	}
+
+	if s.HasFatalError() {
+		attrs = append(attrs, slog.Any("fatal_error", *s.fatalError))
+	}
+
+	elementAttrs := make([]slog.Attr, 0, len(s.elements))
+	for i, element := range s.items() {
+		elementAttrs = append(elementAttrs, slog.Any(strconv.Itoa(i), element))
+	}
+
+	attrs = append(attrs, slog.Attr{Key: "errors", Value: slog.GroupValue(elementAttrs...)})
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements the slog.LogValuer interface. The report is logged like a SimpleReport.
+func (c *ConcurrentReport) LogValue() slog.Value {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.simple().LogValue()
+}
+
+// LogValue implements the slog.LogValuer interface. The items in the scope of the view are logged like a
+// SimpleReport.
+func (c *scopedReport) LogValue() slog.Value {
+	view := c.view()
+	if valuer, ok := view.(slog.LogValuer); ok {
+		return valuer.LogValue()
+	}
+
+	return slog.StringValue(view.Error())
+}
+
+// metadataLogValue returns the metadata as group ordered by key.
+func metadataLogValue(metadata ErrorMetadata) slog.Value {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.String(key, metadata[key]))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// attributesLogValue returns the attributes as group ordered by key.
+func attributesLogValue(attributes Attributes) slog.Value {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Attr{Key: key, Value: valueLogValue(attributes[key])})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// valueLogValue converts a typed metadata value into the slog value of the same kind.
+func valueLogValue(value Value) slog.Value {
+	switch value.Kind() {
+	case KindInt64:
+		v, _ := value.AsInt64()
+		return slog.Int64Value(v)
+	case KindFloat64:
+		v, _ := value.AsFloat64()
+		return slog.Float64Value(v)
+	case KindBool:
+		v, _ := value.AsBool()
+		return slog.BoolValue(v)
+	case KindTime:
+		v, _ := value.AsTime()
+		return slog.TimeValue(v)
+	case KindDuration:
+		v, _ := value.AsDuration()
+		return slog.DurationValue(v)
+	case KindMap:
+		v, _ := value.AsMap()
+		return attributesLogValue(v)
+	case KindSlice:
+		return slog.AnyValue(value.Any())
+	default:
+		return slog.StringValue(value.String())
+	}
+}
+
+// SlogHandler is a slog.Handler which adds every record at or above a level as error item to a report. Records can
+// be passed on to another handler as well.
+type SlogHandler struct {
+	next    slog.Handler
+	report  Report
+	level   slog.Leveler
+	flagKey string
+	attrs   []slog.Attr
+	groups  []string
+}
+
+// SlogHandlerOption configures a SlogHandler on creation.
+type SlogHandlerOption func(*SlogHandler)
+
+// WithSlogLevel sets the minimum level of records which are added to the report. The default is slog.LevelError.
+func WithSlogLevel(level slog.Leveler) SlogHandlerOption {
+	return func(h *SlogHandler) {
+		h.level = level
+	}
+}
+
+// WithSlogFlagKey sets the key of the attribute whose value becomes the flag of the error item. The default is
+// "flag". Keys of attributes in groups are joined by a dot, e.g. "request.kind". Records without the attribute get a
+// default flag.
+func WithSlogFlagKey(key string) SlogHandlerOption {
+	return func(h *SlogHandler) {
+		h.flagKey = key
+	}
+}
+
+// NewSlogHandler creates a handler which adds records to the report and passes all records on to the next handler.
+// The next handler may be nil, then records are only added to the report. Loggers are usually shared by goroutines,
+// so the report must be safe for concurrent use, e.g. a ConcurrentReport.
+func NewSlogHandler(next slog.Handler, report Report, options ...SlogHandlerOption) *SlogHandler {
+	handler := &SlogHandler{
+		next:    next,
+		report:  report,
+		level:   slog.LevelError,
+		flagKey: "flag",
+	}
+
+	for _, option := range options {
+		option(handler)
+	}
+
+	return handler
+}
+
+// Enabled implements the slog.Handler interface. It reports whether the record is added to the report or handled by
+// the next handler.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if level >= h.level.Level() {
+		return true
+	}
+
+	return h.next != nil && h.next.Enabled(ctx, level)
+}
+
+// Handle implements the slog.Handler interface. A record at or above the level is added as error item with the
+// message of the record. The attributes become the metadata, nested groups are joined by a dot. The first attribute
+// holding an error becomes the wrapped error, so errors.Is and errors.As keep working on the report. The source
+// location of the record becomes the caller of the item.
+func (h *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level >= h.level.Level() {
+		AddReportError(h.report, h.reportError(record))
+	}
+
+	if h.next != nil && h.next.Enabled(ctx, record.Level) {
+		return h.next.Handle(ctx, record)
+	}
+
+	return nil
+}
+
+// WithAttrs implements the slog.Handler interface.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handler := h.clone()
+	for _, attr := range attrs {
+		handler.attrs = append(handler.attrs, h.qualify(attr))
+	}
+
+	if h.next != nil {
+		handler.next = h.next.WithAttrs(attrs)
+	}
+
+	return handler
+}
+
+// WithGroup implements the slog.Handler interface.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	handler := h.clone()
+	handler.groups = append(handler.groups, name)
+
+	if h.next != nil {
+		handler.next = h.next.WithGroup(name)
+	}
+
+	return handler
+}
+
+// clone returns a copy of the handler which does not share attributes and groups with the handler.
+func (h *SlogHandler) clone() *SlogHandler {
+	handler := *h
+	handler.attrs = append([]slog.Attr{}, h.attrs...)
+	handler.groups = append([]string{}, h.groups...)
+
+	return &handler
+}
+
+// qualify prefixes the key of the attribute with the open groups of the handler.
+func (h *SlogHandler) qualify(attr slog.Attr) slog.Attr {
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		attr.Key = h.groups[i] + "." + attr.Key
+	}
+
+	return attr
+}
+
+// reportError converts the record into an error item.
+func (h *SlogHandler) reportError(record slog.Record) ReportError {
+	element := ReportError{
+		Metadata: ErrorMetadata{},
+		Flag:     ErrorFlagNone,
+		Severity: severityForLevel(record.Level),
+		Time:     record.Time,
+	}
+
+	var wrappedError error
+	addAttr := func(attr slog.Attr) {
+		for _, flatAttr := range flattenAttr(attr) {
+			value := flatAttr.Value.Resolve()
+			if err, ok := value.Any().(error); ok && wrappedError == nil && value.Kind() == slog.KindAny {
+				wrappedError = err
+				continue
+			}
+
+			if h.flagKey != "" && flatAttr.Key == h.flagKey {
+				element.Flag = ErrorFlag(value.String())
+				continue
+			}
+
+			element.Metadata[flatAttr.Key] = value.String()
+		}
+	}
+
+	for _, attr := range h.attrs {
+		addAttr(attr)
+	}
+
+	record.Attrs(func(attr slog.Attr) bool {
+		addAttr(h.qualify(attr))
+		return true
+	})
+
+	element.WrappedError = errors.New(record.Message)
+	if wrappedError != nil {
+		element.WrappedError = fmt.Errorf("%s: %w", record.Message, wrappedError)
+	}
+
+	if len(element.Metadata) == 0 {
+		element.Metadata = nil
+	}
+
+	if record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		element.Caller = &Frame{
+			Function: frame.Function,
+			File:     frame.File,
+			Line:     frame.Line,
+		}
+	}
+
+	return element
+}
+
+// flattenAttr returns the attribute or, for a group, all attributes of the group with keys joined by a dot.
+func flattenAttr(attr slog.Attr) []slog.Attr {
+	value := attr.Value.Resolve()
+	if value.Kind() != slog.KindGroup {
+		if attr.Key == "" {
+			return nil
+		}
+
+		return []slog.Attr{{Key: attr.Key, Value: value}}
+	}
+
+	var flatAttrs []slog.Attr
+	for _, groupAttr := range value.Group() {
+		if attr.Key != "" {
+			groupAttr.Key = attr.Key + "." + groupAttr.Key
+		}
+
+		flatAttrs = append(flatAttrs, flattenAttr(groupAttr)...)
+	}
+
+	return flatAttrs
+}
+
+// severityForLevel returns the severity matching the slog level. Levels above slog.LevelError become
+// SeverityCritical.
+func severityForLevel(level slog.Level) Severity {
+	switch {
+	case level < slog.LevelInfo:
+		return SeverityDebug
+	case level < slog.LevelWarn:
+		return SeverityInfo
+	case level < slog.LevelError:
+		return SeverityWarning
+	case level == slog.LevelError:
+		return SeverityError
+	default:
+		return SeverityCritical
+	}
+}
@@ -0,0 +1,201 @@
+package yeterr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logJSON logs the value with a JSON handler and returns the logged value of the key.
+func logJSON(t *testing.T, value interface{}) string {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey || attr.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+	logger.Info("", "value", value)
+
+	var logged map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &logged))
+
+	return string(logged["value"])
+}
+
+func TestReportError_LogValue(t *testing.T) {
+	t.Run("should log flag, metadata and severity", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"message": "this simulates a read error",
+			"flag": "read_error",
+			"severity": "error",
+			"metadata": {"filename": "text.txt"}
+		}`, logJSON(t, elementRead))
+	})
+
+	t.Run("should log additional flags, typed attributes and the fatal marker", func(t *testing.T) {
+		reportErr := ReportError{
+			WrappedError: errIOError,
+			Attributes:   Attributes{"retries": Int64Value(3), "file": MapValue(Attributes{"name": StringValue("text.txt")})},
+			Flag:         flagIOError,
+			Flags:        []ErrorFlag{flagReadError},
+			Severity:     SeverityFatal,
+			Scope:        "import",
+			Occurrences:  2,
+			Fatal:        true,
+		}
+
+		assert.JSONEq(t, `{
+			"message": "this simulates an IO error",
+			"flag": "io_error",
+			"flags": ["read_error"],
+			"severity": "fatal",
+			"scope": "import",
+			"attributes": {"file": {"name": "text.txt"}, "retries": 3},
+			"occurrences": 2,
+			"fatal": true
+		}`, logJSON(t, reportErr))
+	})
+
+	t.Run("should log an item without wrapped error", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"message": "<nil>",
+			"flag": "none",
+			"severity": "error"
+		}`, logJSON(t, ReportError{Flag: ErrorFlagNone}))
+	})
+}
+
+func TestSimpleReport_LogValue(t *testing.T) {
+	t.Run("should log an empty report", func(t *testing.T) {
+		assert.JSONEq(t, `{"count": 0}`, logJSON(t, NewSimpleReport()))
+	})
+
+	t.Run("should log the fatal error and all items", func(t *testing.T) {
+		report := NewSimpleReport()
+		report.AddFlaggedError(errReadError, nil, flagReadError)
+		report.AddFlaggedFatalError(errWriteError, nil, flagWriteError)
+
+		fatalJSON := `{"message": "this simulates a write error", "flag": "write_error", "severity": "fatal", "fatal": true}`
+		assert.JSONEq(t, `{
+			"count": 2,
+			"fatal_error": `+fatalJSON+`,
+			"errors": {
+				"0": {"message": "this simulates a read error", "flag": "read_error", "severity": "error"},
+				"1": `+fatalJSON+`
+			}
+		}`, logJSON(t, report))
+	})
+
+	t.Run("should log concurrent reports like simple reports", func(t *testing.T) {
+		report := NewConcurrentReport(WithCapacity(1, OverflowReject))
+		report.AddError(errReadError, nil)
+		report.AddError(errWriteError, nil)
+
+		assert.JSONEq(t, `{
+			"count": 1,
+			"dropped": 1,
+			"errors": {"0": {"message": "this simulates a read error", "flag": "none", "severity": "error"}}
+		}`, logJSON(t, report))
+	})
+}
+
+func TestSlogHandler(t *testing.T) {
+	t.Run("should add records at or above the level", func(t *testing.T) {
+		report := NewConcurrentReport()
+		logger := slog.New(NewSlogHandler(nil, report, WithSlogLevel(slog.LevelWarn)))
+
+		logger.Info("connected")
+		logger.Warn("slow response", "duration_ms", 1200)
+		logger.Error("request failed")
+		logger.Log(context.Background(), slog.LevelError+4, "data lost")
+
+		require.Equal(t, 3, report.Count())
+		assert.Equal(t, "slow response", report.FirstError().Error())
+		assert.Equal(t, SeverityWarning, report.FirstError().Severity)
+		assert.Equal(t, ErrorMetadata{"duration_ms": "1200"}, report.FirstError().Metadata)
+		assert.Equal(t, SeverityError, report.AllErrors()[1].Severity)
+		assert.Equal(t, SeverityCritical, report.LastError().Severity)
+	})
+
+	t.Run("should use the logging call site as caller", func(t *testing.T) {
+		report := NewConcurrentReport(WithCapture(CaptureCaller))
+		logger := slog.New(NewSlogHandler(nil, report))
+
+		logger.Error("request failed")
+
+		require.NotNil(t, report.FirstError().Caller)
+		assert.True(t, strings.HasSuffix(report.FirstError().Caller.File, "slog_test.go"))
+		assert.Contains(t, report.FirstError().Caller.Function, "TestSlogHandler")
+	})
+
+	t.Run("should map attributes to metadata and flag", func(t *testing.T) {
+		report := NewConcurrentReport()
+		logger := slog.New(NewSlogHandler(nil, report, WithSlogFlagKey("request.kind"))).
+			With("job_id", 42).
+			WithGroup("request")
+
+		logger.Error("request failed", "kind", "timeout", slog.Group("user", "id", "u1"))
+
+		require.Equal(t, 1, report.Count())
+		assert.Equal(t, ErrorFlag("timeout"), report.FirstError().Flag)
+		assert.Equal(t, ErrorMetadata{"job_id": "42", "request.user.id": "u1"}, report.FirstError().Metadata)
+	})
+
+	t.Run("should map the configured attribute to the flag", func(t *testing.T) {
+		report := NewConcurrentReport()
+		logger := slog.New(NewSlogHandler(nil, report))
+
+		logger.Error("read failed", "flag", flagReadError)
+		logger.Error("unknown")
+
+		assert.Equal(t, flagReadError, report.FirstError().Flag)
+		assert.Nil(t, report.FirstError().Metadata)
+		assert.Equal(t, ErrorFlagNone, report.LastError().Flag)
+	})
+
+	t.Run("should wrap the logged error", func(t *testing.T) {
+		report := NewConcurrentReport()
+		logger := slog.New(NewSlogHandler(nil, report))
+
+		logger.Error("read failed", "err", errReadError)
+
+		assert.Equal(t, "read failed: this simulates a read error", report.FirstError().Error())
+		assert.True(t, errors.Is(report, errReadError))
+	})
+
+	t.Run("should pass records on to the next handler", func(t *testing.T) {
+		var buffer bytes.Buffer
+		report := NewConcurrentReport()
+		next := slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelInfo})
+		logger := slog.New(NewSlogHandler(next, report))
+
+		logger.Debug("ignored")
+		logger.Info("connected")
+		logger.Error("request failed")
+
+		assert.Equal(t, 1, report.Count())
+		assert.Contains(t, buffer.String(), "msg=connected")
+		assert.Contains(t, buffer.String(), `msg="request failed"`)
+		assert.NotContains(t, buffer.String(), "ignored")
+	})
+
+	t.Run("should be enabled for the level and the next handler", func(t *testing.T) {
+		handler := NewSlogHandler(slog.NewTextHandler(&bytes.Buffer{}, nil), NewConcurrentReport())
+
+		assert.False(t, handler.Enabled(context.Background(), slog.LevelDebug))
+		assert.True(t, handler.Enabled(context.Background(), slog.LevelInfo))
+		assert.False(t, NewSlogHandler(nil, NewConcurrentReport()).Enabled(context.Background(), slog.LevelWarn))
+		assert.True(t, NewSlogHandler(nil, NewConcurrentReport()).Enabled(context.Background(), slog.LevelError))
+	})
+}
@@ -11,3 +11,15 @@ const (
 )
 
 type ErrorMetadata map[string]string
+
+// FlagMatch defines how the flags of an error item are matched against a set of flags.
+type FlagMatch int
+
+const (
+	// FlagMatchAny matches error items which have at least one of the flags.
+	FlagMatchAny FlagMatch = iota
+	// FlagMatchAll matches error items which have all of the flags.
+	FlagMatchAll
+	// FlagMatchNone matches error items which have none of the flags.
+	FlagMatchNone
+)
@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pvormste/yeterr"
+)
+
+// PathKey is the key of the path in the attributes and metadata of recorded error items.
+const PathKey = "path"
+
+// Segment is a single segment of a path. It is either a field name or an index.
+type Segment struct {
+	Field   string
+	Index   int
+	IsIndex bool
+}
+
+// Path is the structured path of a validated value, e.g. user[3].email.
+type Path []Segment
+
+// Field returns a new path extended by the field.
+func (p Path) Field(name string) Path {
+	return p.append(Segment{Field: name})
+}
+
+// Index returns a new path extended by the index.
+func (p Path) Index(index int) Path {
+	return p.append(Segment{Index: index, IsIndex: true})
+}
+
+// append returns a new path extended by the segment which does not share its memory with the path.
+func (p Path) append(segment Segment) Path {
+	path := make(Path, 0, len(p)+1)
+	path = append(path, p...)
+
+	return append(path, segment)
+}
+
+// HasPrefix returns true if the path starts with all segments of the prefix.
+func (p Path) HasPrefix(prefix Path) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+
+	for i, segment := range prefix {
+		if p[i] != segment {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the path in dotted notation, e.g. "user[3].email".
+func (p Path) String() string {
+	var builder strings.Builder
+	for i, segment := range p {
+		if segment.IsIndex {
+			builder.WriteString("[" + strconv.Itoa(segment.Index) + "]")
+			continue
+		}
+
+		if i > 0 {
+			builder.WriteString(".")
+		}
+
+		builder.WriteString(segment.Field)
+	}
+
+	return builder.String()
+}
+
+// JSONPointer returns the path as JSON Pointer according to RFC 6901, e.g. "/user/3/email".
+func (p Path) JSONPointer() string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+
+	var builder strings.Builder
+	for _, segment := range p {
+		builder.WriteString("/")
+		if segment.IsIndex {
+			builder.WriteString(strconv.Itoa(segment.Index))
+			continue
+		}
+
+		builder.WriteString(escaper.Replace(segment.Field))
+	}
+
+	return builder.String()
+}
+
+// value returns the path as typed metadata value. Fields are strings and indexes are integers.
+func (p Path) value() yeterr.Value {
+	values := make([]yeterr.Value, 0, len(p))
+	for _, segment := range p {
+		if segment.IsIndex {
+			values = append(values, yeterr.IntValue(segment.Index))
+			continue
+		}
+
+		values = append(values, yeterr.StringValue(segment.Field))
+	}
+
+	return yeterr.SliceValue(values...)
+}
+
+// PathOf returns the path of an error item recorded by a validation node. It returns false if the item does not have
+// a structured path.
+func PathOf(reportErr yeterr.ReportError) (Path, bool) {
+	values, ok := reportErr.Attributes.GetSlice(PathKey)
+	if !ok {
+		return nil, false
+	}
+
+	path := make(Path, 0, len(values))
+	for _, value := range values {
+		if index, ok := value.AsInt64(); ok {
+			path = append(path, Segment{Index: int(index), IsIndex: true})
+			continue
+		}
+
+		field, ok := value.AsString()
+		if !ok {
+			return nil, false
+		}
+
+		path = append(path, Segment{Field: field})
+	}
+
+	return path, true
+}
+
+// ByPathPrefix returns a predicate matching error items whose path starts with the prefix.
+func ByPathPrefix(prefix Path) yeterr.Predicate {
+	return func(reportErr yeterr.ReportError) bool {
+		path, ok := PathOf(reportErr)
+		return ok && path.HasPrefix(prefix)
+	}
+}
+
+// FilterByPathPrefix returns only those error items of the report as new report whose path starts with the prefix.
+func FilterByPathPrefix(report yeterr.Report, prefix Path) yeterr.Report {
+	return report.Filter(ByPathPrefix(prefix))
+}
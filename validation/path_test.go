@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+)
+
+func TestPath_String(t *testing.T) {
+	assert.Equal(t, "", Path{}.String())
+	assert.Equal(t, "user[3].email", Path{}.Field("user").Index(3).Field("email").String())
+	assert.Equal(t, "[0][1].name", Path{}.Index(0).Index(1).Field("name").String())
+}
+
+func TestPath_JSONPointer(t *testing.T) {
+	assert.Equal(t, "", Path{}.JSONPointer())
+	assert.Equal(t, "/user/3/email", Path{}.Field("user").Index(3).Field("email").JSONPointer())
+	assert.Equal(t, "/a~1b/m~0n", Path{}.Field("a/b").Field("m~n").JSONPointer())
+}
+
+func TestPath_Field(t *testing.T) {
+	t.Run("should not share memory between extended paths", func(t *testing.T) {
+		users := Path{}.Field("users")
+		first := users.Index(0)
+		second := users.Index(1)
+
+		assert.Equal(t, "users[0]", first.String())
+		assert.Equal(t, "users[1]", second.String())
+	})
+}
+
+func TestPath_HasPrefix(t *testing.T) {
+	path := Path{}.Field("user").Index(3).Field("email")
+
+	assert.True(t, path.HasPrefix(nil))
+	assert.True(t, path.HasPrefix(Path{}.Field("user")))
+	assert.True(t, path.HasPrefix(Path{}.Field("user").Index(3)))
+	assert.False(t, path.HasPrefix(Path{}.Field("user").Index(4)))
+	assert.False(t, path.HasPrefix(Path{}.Field("user").Field("3")))
+	assert.False(t, Path{}.Field("user").HasPrefix(path))
+}
+
+func TestPathOf(t *testing.T) {
+	t.Run("should return the path of a recorded item", func(t *testing.T) {
+		report := yeterr.NewSimpleReport()
+		Root(report).Field("user").Index(3).AddError(errors.New("invalid user"), yeterr.ErrorFlagNone)
+
+		path, ok := PathOf(*report.FirstError())
+		require.True(t, ok)
+		assert.Equal(t, Path{}.Field("user").Index(3), path)
+	})
+
+	t.Run("should return false for items without a path", func(t *testing.T) {
+		_, ok := PathOf(yeterr.ReportError{WrappedError: errors.New("invalid user")})
+		assert.False(t, ok)
+
+		_, ok = PathOf(yeterr.ReportError{Attributes: yeterr.Attributes{PathKey: yeterr.SliceValue(yeterr.BoolValue(true))}})
+		assert.False(t, ok)
+	})
+}
+
+func TestFilterByPathPrefix(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	root := Root(report)
+	root.Field("user").Index(0).Field("email").Required("")
+	root.Field("user").Index(1).Field("email").Required("")
+	root.Field("name").Required("")
+	report.AddError(errors.New("without path"), nil)
+
+	assert.Equal(t, 2, FilterByPathPrefix(report, Path{}.Field("user")).Count())
+	assert.Equal(t, 1, FilterByPathPrefix(report, Path{}.Field("user").Index(1)).Count())
+	assert.Equal(t, 3, FilterByPathPrefix(report, nil).Count())
+}
@@ -0,0 +1,179 @@
+// Package validation uses a report as validation result. Values are validated at a structured path, every failed
+// rule is recorded as flagged error item with its path.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/pvormste/yeterr"
+)
+
+// Flags of the error items recorded by the rule helpers.
+const (
+	FlagRequired  yeterr.ErrorFlag = "required"
+	FlagMinLength yeterr.ErrorFlag = "min_length"
+	FlagMaxLength yeterr.ErrorFlag = "max_length"
+	FlagPattern   yeterr.ErrorFlag = "pattern"
+	FlagEnum      yeterr.ErrorFlag = "enum"
+)
+
+// MessageKeyPrefix prefixes the flag of an error item recorded by the rule helpers to its message key, e.g.
+// "validation.required". The path and the attributes of the rule are the parameters of the message template.
+const MessageKeyPrefix = "validation."
+
+// Errors wrapped by the error items recorded by the rule helpers.
+var (
+	ErrRequired        = errors.New("value is required")
+	ErrTooShort        = errors.New("value is too short")
+	ErrTooLong         = errors.New("value is too long")
+	ErrPatternMismatch = errors.New("value does not match the pattern")
+	ErrNotAllowed      = errors.New("value is not allowed")
+)
+
+// Node is a position in the validated data. It records error items into the report with its path.
+type Node struct {
+	report yeterr.Report
+	path   Path
+}
+
+// Root returns the root node of the validated data which records error items into the report.
+func Root(report yeterr.Report) Node {
+	return Node{
+		report: report,
+	}
+}
+
+// Field returns the node of the field.
+func (n Node) Field(name string) Node {
+	return Node{
+		report: n.report,
+		path:   n.path.Field(name),
+	}
+}
+
+// Index returns the node of the index.
+func (n Node) Index(index int) Node {
+	return Node{
+		report: n.report,
+		path:   n.path.Index(index),
+	}
+}
+
+// Path returns the path of the node.
+func (n Node) Path() Path {
+	return n.path
+}
+
+// Report returns the report the node records into.
+func (n Node) Report() yeterr.Report {
+	return n.report
+}
+
+// AddError records the error with the flag and the path of the node. The path is added as structured attribute and in
+// dotted notation as metadata.
+func (n Node) AddError(err error, flag yeterr.ErrorFlag) {
+	n.addError(err, flag, "", nil)
+}
+
+// addError records the error with the flag, the message key, the path of the node and the additional attributes.
+func (n Node) addError(err error, flag yeterr.ErrorFlag, messageKey string, attributes yeterr.Attributes) {
+	if attributes == nil {
+		attributes = yeterr.Attributes{}
+	}
+
+	attributes[PathKey] = n.path.value()
+
+	yeterr.AddReportError(n.report, yeterr.ReportError{
+		WrappedError: err,
+		Metadata:     yeterr.ErrorMetadata{PathKey: n.path.String()},
+		Attributes:   attributes,
+		MessageKey:   messageKey,
+		Flag:         flag,
+		Severity:     yeterr.SeverityError,
+	})
+}
+
+// addRuleError records the error of a rule with the flag and the message key of the flag.
+func (n Node) addRuleError(err error, flag yeterr.ErrorFlag, attributes yeterr.Attributes) {
+	n.addError(err, flag, MessageKeyPrefix+flag.String(), attributes)
+}
+
+// wrap returns the rule error prefixed with the path of the node.
+func (n Node) wrap(ruleErr error, format string, args ...interface{}) error {
+	detail := ""
+	if format != "" {
+		detail = ", " + fmt.Sprintf(format, args...)
+	}
+
+	if len(n.path) == 0 {
+		return fmt.Errorf("%w%s", ruleErr, detail)
+	}
+
+	return fmt.Errorf("%s: %w%s", n.path, ruleErr, detail)
+}
+
+// Required records an error flagged with FlagRequired if the value is empty. It returns true if the value is valid.
+func (n Node) Required(value string) bool {
+	if value != "" {
+		return true
+	}
+
+	n.addRuleError(n.wrap(ErrRequired, ""), FlagRequired, nil)
+	return false
+}
+
+// MinLength records an error flagged with FlagMinLength if the value has fewer characters than the minimum length. It
+// returns true if the value is valid.
+func (n Node) MinLength(value string, minLength int) bool {
+	if utf8.RuneCountInString(value) >= minLength {
+		return true
+	}
+
+	attributes := yeterr.Attributes{"min": yeterr.IntValue(minLength)}
+	n.addRuleError(n.wrap(ErrTooShort, "minimum length is %d", minLength), FlagMinLength, attributes)
+	return false
+}
+
+// MaxLength records an error flagged with FlagMaxLength if the value has more characters than the maximum length. It
+// returns true if the value is valid.
+func (n Node) MaxLength(value string, maxLength int) bool {
+	if utf8.RuneCountInString(value) <= maxLength {
+		return true
+	}
+
+	attributes := yeterr.Attributes{"max": yeterr.IntValue(maxLength)}
+	n.addRuleError(n.wrap(ErrTooLong, "maximum length is %d", maxLength), FlagMaxLength, attributes)
+	return false
+}
+
+// Pattern records an error flagged with FlagPattern if the value does not match the regular expression. It returns
+// true if the value is valid.
+func (n Node) Pattern(value string, pattern *regexp.Regexp) bool {
+	if pattern.MatchString(value) {
+		return true
+	}
+
+	attributes := yeterr.Attributes{"pattern": yeterr.StringValue(pattern.String())}
+	n.addRuleError(n.wrap(ErrPatternMismatch, "pattern is %q", pattern), FlagPattern, attributes)
+	return false
+}
+
+// Enum records an error flagged with FlagEnum if the value is not one of the allowed values. It returns true if the
+// value is valid.
+func (n Node) Enum(value string, allowed ...string) bool {
+	allowedValues := make([]yeterr.Value, 0, len(allowed))
+	for _, allowedValue := range allowed {
+		if value == allowedValue {
+			return true
+		}
+
+		allowedValues = append(allowedValues, yeterr.StringValue(allowedValue))
+	}
+
+	attributes := yeterr.Attributes{"allowed": yeterr.SliceValue(allowedValues...)}
+	n.addRuleError(n.wrap(ErrNotAllowed, "allowed values are %q", allowed), FlagEnum, attributes)
+	return false
+}
@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pvormste/yeterr"
+)
+
+func TestNode_AddError(t *testing.T) {
+	errCustom := errors.New("custom error")
+
+	report := yeterr.NewSimpleReport()
+	node := Root(report).Field("user").Index(3).Field("email")
+	node.AddError(errCustom, "custom")
+
+	require.Equal(t, 1, report.Count())
+	reportErr := report.FirstError()
+	assert.Equal(t, errCustom, reportErr.Unwrap())
+	assert.Equal(t, yeterr.ErrorFlag("custom"), reportErr.Flag)
+	assert.Equal(t, yeterr.ErrorMetadata{PathKey: "user[3].email"}, reportErr.Metadata)
+	assert.Equal(t, "user[3].email", node.Path().String())
+	assert.Equal(t, report, node.Report())
+	assert.Empty(t, reportErr.MessageKey)
+}
+
+func TestNode_Required(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	root := Root(report)
+
+	assert.True(t, root.Field("name").Required("Jane"))
+	assert.False(t, root.Field("email").Required(""))
+	assert.False(t, root.Required(""))
+
+	require.Equal(t, 2, report.Count())
+	assert.Equal(t, "email: value is required", report.FirstError().Error())
+	assert.Equal(t, FlagRequired, report.FirstError().Flag)
+	assert.Equal(t, "validation.required", report.FirstError().MessageKey)
+	assert.True(t, errors.Is(report.FirstError(), ErrRequired))
+	assert.Equal(t, "value is required", report.LastError().Error())
+}
+
+func TestNode_MinLength(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	node := Root(report).Field("name")
+
+	assert.True(t, node.MinLength("Jürgen", 6))
+	assert.False(t, node.MinLength("Jo", 3))
+
+	require.Equal(t, 1, report.Count())
+	assert.Equal(t, "name: value is too short, minimum length is 3", report.FirstError().Error())
+	assert.Equal(t, FlagMinLength, report.FirstError().Flag)
+	assert.True(t, errors.Is(report.FirstError(), ErrTooShort))
+
+	minLength, ok := report.FirstError().Attributes.GetInt64("min")
+	require.True(t, ok)
+	assert.Equal(t, int64(3), minLength)
+}
+
+func TestNode_MaxLength(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	node := Root(report).Field("name")
+
+	assert.True(t, node.MaxLength("Jürgen", 6))
+	assert.False(t, node.MaxLength("Johnny", 5))
+
+	require.Equal(t, 1, report.Count())
+	assert.Equal(t, "name: value is too long, maximum length is 5", report.FirstError().Error())
+	assert.Equal(t, FlagMaxLength, report.FirstError().Flag)
+	assert.True(t, errors.Is(report.FirstError(), ErrTooLong))
+}
+
+func TestNode_Pattern(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	node := Root(report).Field("zip")
+	pattern := regexp.MustCompile(`^\d{5}$`)
+
+	assert.True(t, node.Pattern("12345", pattern))
+	assert.False(t, node.Pattern("1234a", pattern))
+
+	require.Equal(t, 1, report.Count())
+	assert.Equal(t, `zip: value does not match the pattern, pattern is "^\\d{5}$"`, report.FirstError().Error())
+	assert.Equal(t, FlagPattern, report.FirstError().Flag)
+	assert.True(t, errors.Is(report.FirstError(), ErrPatternMismatch))
+}
+
+func TestNode_Enum(t *testing.T) {
+	report := yeterr.NewSimpleReport()
+	node := Root(report).Field("role")
+
+	assert.True(t, node.Enum("admin", "admin", "user"))
+	assert.False(t, node.Enum("guest", "admin", "user"))
+
+	require.Equal(t, 1, report.Count())
+	assert.Equal(t, `role: value is not allowed, allowed values are ["admin" "user"]`, report.FirstError().Error())
+	assert.Equal(t, FlagEnum, report.FirstError().Flag)
+	assert.True(t, errors.Is(report.FirstError(), ErrNotAllowed))
+
+	allowed, ok := report.FirstError().Attributes.GetSlice("allowed")
+	require.True(t, ok)
+	assert.Len(t, allowed, 2)
+}